@@ -3,9 +3,9 @@
 import { useEffect, useMemo, useState } from "react";
 import { DEFAULT_FAUCET_CREDIT_PATH, DEFAULT_FAUCET_URL, DEFAULT_REST_URL, DEFAULT_RPC_URL } from "@/lib/config";
 import { getBalances, getNodeInfo, type Coin } from "@/lib/cosmos";
-import { getListings, statusToLabel, type Listing } from "@/lib/amp";
+import { getCategories, getListings, getListingsByBuyer, getListingsByCategory, getListingsBySeller, formatCoins, listingLabel, statusToLabel, type Listing } from "@/lib/amp";
 import { useLocalStorage } from "@/lib/useLocalStorage";
-import { buildMsgBuyItem, buildMsgListItem, buildMsgRecordActivity, getSigningClient, type ChainConfig } from "@/lib/wallet";
+import { buildMsgBatchBuy, buildMsgBuyItem, buildMsgListItem, buildMsgRecordActivity, getSigningClient, type ChainConfig } from "@/lib/wallet";
 import { getBlockMetas, getLatestHeight, subscribeNewBlocks, type BlockHeader } from "@/lib/tmws";
 import { getBlockTxEvents, type TxEvent } from "@/lib/tx";
 import { getScore } from "@/lib/points";
@@ -30,6 +30,22 @@ export default function Home() {
   const [error, setError] = useState<string | null>(null);
   const [faucetMsg, setFaucetMsg] = useState<string | null>(null);
   const [listings, setListings] = useState<Listing[]>([]);
+  const [sellingListings, setSellingListings] = useState<Listing[]>([]);
+  const [boughtListings, setBoughtListings] = useState<Listing[]>([]);
+  const [categories, setCategories] = useState<string[]>([]);
+  const [browseCategory, setBrowseCategory] = useState("");
+  const [categoryListings, setCategoryListings] = useState<Listing[]>([]);
+  const [cart, setCart] = useState<Listing[]>([]);
+  // cartTotal sums the first price option of every cart item per denom, sorted by denom as
+  // the chain expects max_total to be
+  const cartTotal = useMemo<Coin[]>(() => {
+    const sums = new Map<string, bigint>();
+    for (const l of cart) {
+      const p = l.price[0];
+      if (p) sums.set(p.denom, (sums.get(p.denom) ?? BigInt(0)) + BigInt(p.amount));
+    }
+    return [...sums.keys()].sort().map((denom) => ({ denom, amount: String(sums.get(denom)) }));
+  }, [cart]);
   const [txPending, setTxPending] = useState(false);
   const [txMsg, setTxMsg] = useState<string | null>(null);
   const [walletAddress, setWalletAddress] = useState<string>("");
@@ -53,6 +69,8 @@ export default function Home() {
   const [sellAssetAmt, setSellAssetAmt] = useState("1");
   const [sellPriceDenom, setSellPriceDenom] = useState("stake");
   const [sellPriceAmt, setSellPriceAmt] = useState("1000");
+  const [sellCategory, setSellCategory] = useState("");
+  const [sellTags, setSellTags] = useState("");
   // send form state
   const [sendTo, setSendTo] = useState("");
   const [sendDenom, setSendDenom] = useState("stake");
@@ -62,14 +80,22 @@ export default function Home() {
     setError(null);
     setFaucetMsg(null);
     try {
-      const [info, bals, allListings] = await Promise.all([
+      const [info, bals, allListings, selling, bought, cats, inCategory] = await Promise.all([
         getNodeInfo(restUrl),
         activeAddress ? getBalances(restUrl, activeAddress) : Promise.resolve({ balances: [] as Coin[] }),
         getListings(restUrl),
+        activeAddress ? getListingsBySeller(restUrl, activeAddress) : Promise.resolve([] as Listing[]),
+        activeAddress ? getListingsByBuyer(restUrl, activeAddress) : Promise.resolve([] as Listing[]),
+        getCategories(restUrl),
+        browseCategory ? getListingsByCategory(restUrl, browseCategory) : Promise.resolve([] as Listing[]),
       ]);
       setNodeInfo(info?.default_node_info || info?.node_info || info);
       setBalances(bals.balances || []);
       setListings(allListings);
+      setSellingListings(selling);
+      setBoughtListings(bought);
+      setCategories(cats);
+      setCategoryListings(inCategory);
     } catch (e: any) {
       setError(e?.message || "failed to fetch");
     }
@@ -79,7 +105,7 @@ export default function Home() {
     // initial fetch
     refresh();
     // eslint-disable-next-line react-hooks/exhaustive-deps
-  }, [restUrl, activeAddress]);
+  }, [restUrl, activeAddress, browseCategory]);
 
   useEffect(() => {
     (async () => {
@@ -302,8 +328,8 @@ export default function Home() {
                       return (
                         <li key={String(l.id)} className="py-1">
                           <span className="font-medium">#{String(l.id)}</span>
-                          <span className="ml-2">{l.title || l.description || `${l.asset.amount} ${l.asset.denom}`}</span>
-                          <span className="ml-2 text-zinc-500">for {l.price.amount} {l.price.denom}</span>
+                          <span className="ml-2">{l.title || l.description || listingLabel(l)}</span>
+                          <span className="ml-2 text-zinc-500">for {formatCoins(l.price, " or ")}</span>
                           <span className="ml-2 rounded bg-zinc-100 px-2 py-0.5 text-xs text-zinc-700 dark:bg-zinc-800 dark:text-zinc-300">{status}</span>
                           <span className="ml-2 text-xs text-zinc-500">owner {nowOwner?.slice(0, 10)}…{nowOwner?.slice(-6)}</span>
                         </li>
@@ -570,6 +596,17 @@ export default function Home() {
                   <input value={sellPriceDenom} onChange={(e) => setSellPriceDenom(e.target.value)} className="w-40 rounded-md border border-black/10 bg-white p-2 dark:border-white/15 dark:bg-zinc-900" placeholder="stake" />
                 </div>
               </div>
+              <label className="flex flex-col gap-1 text-sm">
+                <span className="text-zinc-600 dark:text-zinc-400">Category</span>
+                <select value={sellCategory} onChange={(e) => setSellCategory(e.target.value)} className="rounded-md border border-black/10 bg-white p-2 dark:border-white/15 dark:bg-zinc-900">
+                  <option value="">(none)</option>
+                  {categories.map((c) => <option key={c} value={c}>{c}</option>)}
+                </select>
+              </label>
+              <label className="flex flex-col gap-1 text-sm">
+                <span className="text-zinc-600 dark:text-zinc-400">Tags (comma separated)</span>
+                <input value={sellTags} onChange={(e) => setSellTags(e.target.value)} className="rounded-md border border-black/10 bg-white p-2 dark:border-white/15 dark:bg-zinc-900" placeholder="vintage, rare" />
+              </label>
             </div>
             <div className="mt-3">
               <button
@@ -588,8 +625,10 @@ export default function Home() {
                       seller: address,
                       title: sellTitle,
                       description: sellDesc,
-                      asset: { amount: sellAssetAmt, denom: sellAssetDenom },
-                      price: { amount: sellPriceAmt, denom: sellPriceDenom },
+                      asset: [{ amount: sellAssetAmt, denom: sellAssetDenom }],
+                      price: [{ amount: sellPriceAmt, denom: sellPriceDenom }],
+                      category: sellCategory || undefined,
+                      tags: sellTags.split(",").map((t) => t.trim().toLowerCase()).filter(Boolean),
                     });
                     const result = await client.signAndBroadcast(address, [msg], "auto");
                     if (result.code !== 0) throw new Error(result.rawLog || `tx failed: ${result.code}`);
@@ -613,15 +652,15 @@ export default function Home() {
           <div className="rounded-md border border-black/10 p-4 dark:border-white/15">
             {activeAddress ? (
               (() => {
-                const mine = listings.filter((l) => l.seller === activeAddress);
+                const mine = sellingListings;
                 if (mine.length === 0) return <p className="text-sm text-zinc-600 dark:text-zinc-400">No listings from this address.</p>;
                 return (
                   <ul className="text-sm text-black dark:text-zinc-50">
                     {mine.map((l) => (
                       <li key={String(l.id)} className="py-1">
                         <span className="font-medium">#{String(l.id)}</span>
-                        <span className="ml-2">{l.title || l.description || `${l.asset.amount} ${l.asset.denom}`}</span>
-                        <span className="ml-2 text-zinc-500">for {l.price.amount} {l.price.denom}</span>
+                        <span className="ml-2">{l.title || l.description || listingLabel(l)}</span>
+                        <span className="ml-2 text-zinc-500">for {formatCoins(l.price, " or ")}</span>
                         <span className="ml-2 rounded bg-zinc-100 px-2 py-0.5 text-xs text-zinc-700 dark:bg-zinc-800 dark:text-zinc-300">{statusToLabel(l.status)}</span>
                         {l.buyer ? <span className="ml-2 text-xs text-zinc-500">buyer {l.buyer.slice(0, 10)}…{l.buyer.slice(-6)}</span> : null}
                       </li>
@@ -640,15 +679,15 @@ export default function Home() {
           <div className="rounded-md border border-black/10 p-4 dark:border-white/15">
             {activeAddress ? (
               (() => {
-                const mine = listings.filter((l) => (l.buyer || "") === activeAddress);
+                const mine = boughtListings;
                 if (mine.length === 0) return <p className="text-sm text-zinc-600 dark:text-zinc-400">No purchases found for this address.</p>;
                 return (
                   <ul className="text-sm text-black dark:text-zinc-50">
                     {mine.map((l) => (
                       <li key={String(l.id)} className="py-1">
                         <span className="font-medium">#{String(l.id)}</span>
-                        <span className="ml-2">{l.title || l.description || `${l.asset.amount} ${l.asset.denom}`}</span>
-                        <span className="ml-2 text-zinc-500">for {l.price.amount} {l.price.denom}</span>
+                        <span className="ml-2">{l.title || l.description || listingLabel(l)}</span>
+                        <span className="ml-2 text-zinc-500">for {formatCoins(l.price, " or ")}</span>
                         <span className="ml-2 text-xs text-zinc-500">seller {l.seller.slice(0, 10)}…{l.seller.slice(-6)}</span>
                       </li>
                     ))}
@@ -662,20 +701,32 @@ export default function Home() {
         </section>
 
         <section className="mb-6">
-          <h2 className="mb-2 text-lg font-medium text-black dark:text-zinc-50">Active Listings (All)</h2>
+          <h2 className="mb-2 text-lg font-medium text-black dark:text-zinc-50">Active Listings ({browseCategory || "All"})</h2>
           <div className="rounded-md border border-black/10 p-4 dark:border-white/15">
-            {listings.length === 0 ? (
+            <label className="mb-3 flex items-center gap-2 text-sm">
+              <span className="text-zinc-600 dark:text-zinc-400">Category</span>
+              <select value={browseCategory} onChange={(e) => setBrowseCategory(e.target.value)} className="rounded-md border border-black/10 bg-white p-1 dark:border-white/15 dark:bg-zinc-900">
+                <option value="">All</option>
+                {categories.map((c) => <option key={c} value={c}>{c}</option>)}
+              </select>
+            </label>
+            {(browseCategory ? categoryListings : listings).length === 0 ? (
               <p className="text-sm text-zinc-600 dark:text-zinc-400">No listings on chain.</p>
             ) : (
               <ul className="text-sm text-black dark:text-zinc-50">
-                {listings
+                {(browseCategory ? categoryListings : listings)
                   .filter((l) => statusToLabel(l.status) === "ACTIVE")
                   .map((l) => (
                     <li key={String(l.id)} className="flex items-center justify-between py-1">
                       <span className="font-medium">#{String(l.id)}</span>
-                      <span className="ml-2 flex-1">{l.title || l.description || `${l.asset.amount} ${l.asset.denom}`}</span>
-                      <span className="ml-2 text-zinc-500">for {l.price.amount} {l.price.denom}</span>
+                      <span className="ml-2 flex-1">{l.title || l.description || listingLabel(l)}</span>
+                      <span className="ml-2 text-zinc-500">for {formatCoins(l.price, " or ")}</span>
                       <span className="ml-2 text-xs text-zinc-500">seller {l.seller.slice(0, 10)}…{l.seller.slice(-6)}</span>
+                      <button
+                        className="ml-3 rounded-md border border-black/10 px-2 py-1 text-xs dark:border-white/15"
+                        onClick={() => setCart((c) => (c.some((x) => x.id === l.id) ? c.filter((x) => x.id !== l.id) : [...c, l]))}
+                        disabled={txPending || (activeAddress && l.seller === activeAddress)}
+                      >{cart.some((x) => x.id === l.id) ? "Remove" : "Add to cart"}</button>
                       <button
                         className="ml-3 rounded-md border border-black/10 px-2 py-1 text-xs dark:border-white/15"
                         onClick={async () => {
@@ -685,7 +736,7 @@ export default function Home() {
                             setTxPending(true);
                             const chain: ChainConfig = { chainId: (nodeInfo?.network as string) || "amp", chainName: "AMP Local", rpc: rpcUrl, rest: restUrl, stakeDenom: "stake", bip44CoinType: 118 };
                             const { client, address } = await getSigningClient(chain);
-                            const msg = buildMsgBuyItem({ buyer: address, listing_id: Number(l.id) });
+                            const msg = buildMsgBuyItem({ buyer: address, listing_id: Number(l.id), denom: l.price[0]?.denom });
                             const result = await client.signAndBroadcast(address, [msg], "auto");
                             if (result.code !== 0) throw new Error(result.rawLog || `tx failed: ${result.code}`);
                             setTxMsg(`Bought! txhash ${result.transactionHash}`);
@@ -706,6 +757,60 @@ export default function Home() {
           </div>
         </section>
 
+        <section className="mb-6">
+          <h2 className="mb-2 text-lg font-medium text-black dark:text-zinc-50">Cart ({cart.length})</h2>
+          <div className="rounded-md border border-black/10 p-4 dark:border-white/15">
+            {cart.length === 0 ? (
+              <p className="text-sm text-zinc-600 dark:text-zinc-400">Add active listings to buy them together in one transaction.</p>
+            ) : (
+              <>
+                <ul className="text-sm text-black dark:text-zinc-50">
+                  {cart.map((l) => (
+                    <li key={String(l.id)} className="py-1">
+                      <span className="font-medium">#{String(l.id)}</span>
+                      <span className="ml-2">{l.title || l.description || listingLabel(l)}</span>
+                      <span className="ml-2 text-zinc-500">for {formatCoins(l.price.slice(0, 1))}</span>
+                    </li>
+                  ))}
+                </ul>
+                <div className="mt-3 flex items-center gap-3">
+                  <span className="text-sm text-zinc-600 dark:text-zinc-400">Total {formatCoins(cartTotal)}</span>
+                  <button
+                    className="rounded-md bg-black px-3 py-2 text-sm text-white dark:bg-white dark:text-black"
+                    onClick={async () => {
+                      setTxMsg(null); setError(null);
+                      if (!activeAddress) { setError("Set active address"); return; }
+                      try {
+                        setTxPending(true);
+                        const chain: ChainConfig = { chainId: (nodeInfo?.network as string) || "amp", chainName: "AMP Local", rpc: rpcUrl, rest: restUrl, stakeDenom: "stake", bip44CoinType: 118 };
+                        const { client, address } = await getSigningClient(chain);
+                        // one tx buys the whole cart or nothing, for at most the total shown
+                        const msg = buildMsgBatchBuy({
+                          buyer: address,
+                          items: cart.map((l) => ({ listing_id: Number(l.id), denom: l.price[0]?.denom })),
+                          max_total: cartTotal,
+                        });
+                        const result = await client.signAndBroadcast(address, [msg], "auto");
+                        if (result.code !== 0) throw new Error(result.rawLog || `tx failed: ${result.code}`);
+                        setTxMsg(`Bought ${cart.length} items! txhash ${result.transactionHash}`);
+                        setCart([]);
+                        try {
+                          const rp = buildMsgRecordActivity({ signer: address, address, action: "buy_item", weight: 20 * cart.length, timestamp: 0 });
+                          await client.signAndBroadcast(address, [rp], "auto");
+                        } catch {}
+                        setTimeout(refresh, 1500);
+                      } catch (e: any) { setError(e?.message || "checkout failed"); }
+                      finally { setTxPending(false); }
+                    }}
+                    disabled={txPending}
+                  >{txPending ? "Buying..." : "Checkout"}</button>
+                  <button className="rounded-md border border-black/10 px-3 py-2 text-sm dark:border-white/15" onClick={() => setCart([])} disabled={txPending}>Clear</button>
+                </div>
+              </>
+            )}
+          </div>
+        </section>
+
         <section className="mb-2">
           <h2 className="mb-2 text-lg font-medium text-black dark:text-zinc-50">Node</h2>
           <div className="rounded-md border border-black/10 p-4 text-sm dark:border-white/15 dark:text-zinc-50">
@@ -7,17 +7,33 @@ export type Listing = {
   seller: string;
   title?: string;
   description?: string;
-  asset: AmpCoin;
-  price: AmpCoin;
+  // asset is the bundle of coins sold; empty when the listing sells an NFT.
+  asset: AmpCoin[];
+  // price lists the accepted payment options; the buyer pays exactly one of them.
+  price: AmpCoin[];
+  remaining_assets?: AmpCoin[];
+  nft?: { class_id: string; nft_id: string };
   status: string | number;
   buyer?: string;
   created_at?: string | number;
+  category?: string;
+  tags?: string[];
 };
 
 export type ListingsResponse = {
   listings: Listing[];
 };
 
+export function formatCoins(coins: AmpCoin[] | undefined, sep = ", "): string {
+  return (coins || []).map((c) => `${c.amount} ${c.denom}`).join(sep);
+}
+
+// listingLabel describes what a listing sells when it has no title or description.
+export function listingLabel(l: Listing): string {
+  if (l.nft?.class_id) return `NFT ${l.nft.class_id}/${l.nft.nft_id}`;
+  return formatCoins(l.asset);
+}
+
 export function statusToLabel(status: string | number): string {
   if (typeof status === "number") {
     return ["ACTIVE", "SOLD", "CANCELLED"][status] || String(status);
@@ -36,3 +52,31 @@ export async function getListings(restUrl = DEFAULT_REST_URL): Promise<Listing[]
   return json.listings || [];
 }
 
+export async function getListingsBySeller(restUrl = DEFAULT_REST_URL, seller: string): Promise<Listing[]> {
+  const res = await fetch(`${restUrl.replace(/\/$/, "")}/amp/amp/v1/listings/seller/${seller}`, { cache: "no-store" });
+  if (!res.ok) throw new Error(`Listings error: ${res.status}`);
+  const json = (await res.json()) as ListingsResponse;
+  return json.listings || [];
+}
+
+export async function getCategories(restUrl = DEFAULT_REST_URL): Promise<string[]> {
+  const res = await fetch(`${restUrl.replace(/\/$/, "")}/amp/amp/v1/categories`, { cache: "no-store" });
+  if (!res.ok) throw new Error(`Categories error: ${res.status}`);
+  const json = (await res.json()) as { categories?: string[] };
+  return json.categories || [];
+}
+
+export async function getListingsByCategory(restUrl = DEFAULT_REST_URL, category: string): Promise<Listing[]> {
+  const res = await fetch(`${restUrl.replace(/\/$/, "")}/amp/amp/v1/listings/category/${encodeURIComponent(category)}`, { cache: "no-store" });
+  if (!res.ok) throw new Error(`Listings error: ${res.status}`);
+  const json = (await res.json()) as ListingsResponse;
+  return json.listings || [];
+}
+
+export async function getListingsByBuyer(restUrl = DEFAULT_REST_URL, buyer: string): Promise<Listing[]> {
+  const res = await fetch(`${restUrl.replace(/\/$/, "")}/amp/amp/v1/listings/buyer/${buyer}`, { cache: "no-store" });
+  if (!res.ok) throw new Error(`Listings error: ${res.status}`);
+  const json = (await res.json()) as ListingsResponse;
+  return json.listings || [];
+}
+
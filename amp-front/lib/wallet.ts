@@ -7,6 +7,7 @@ import { SigningStargateClient, GasPrice } from "@cosmjs/stargate";
 
 export const AMP_TYPEURL_LIST = "/amp.amp.v1.MsgListItem";
 export const AMP_TYPEURL_BUY = "/amp.amp.v1.MsgBuyItem";
+export const AMP_TYPEURL_BATCH_BUY = "/amp.amp.v1.MsgBatchBuy";
 export const AMP_TYPEURL_POINTS_RECORD = "/amp.points.v1.MsgRecordActivity";
 
 type Coin = { denom: string; amount: string };
@@ -64,22 +65,50 @@ export function encodeMsgListItem(value: {
   seller: string;
   title?: string;
   description?: string;
-  asset: Coin;
-  price: Coin;
+  asset: Coin[];
+  price: Coin[];
+  referrer?: string;
+  category?: string;
+  tags?: string[];
 }): Uint8Array {
   const parts: Uint8Array[] = [];
   parts.push(fldString(1, value.seller));
   if (value.title) parts.push(fldString(2, value.title));
   if (value.description) parts.push(fldString(3, value.description));
-  parts.push(fldCoin(4, value.asset));
-  parts.push(fldCoin(5, value.price));
+  for (const c of value.asset) parts.push(fldCoin(4, c));
+  for (const c of value.price) parts.push(fldCoin(5, c));
+  if (value.referrer) parts.push(fldString(12, value.referrer));
+  if (value.category) parts.push(fldString(13, value.category));
+  for (const t of value.tags || []) parts.push(fldString(14, t));
   return concat(...parts);
 }
 
-export function encodeMsgBuyItem(value: { buyer: string; listing_id: number }): Uint8Array {
+// denom picks the price option to pay with; it may be omitted if the listing has a single
+// option. referrer optionally earns a share of the commission of the purchase, and max_fee
+// rejects the purchase if the commission would exceed it.
+export function encodeMsgBuyItem(value: { buyer: string; listing_id: number; denom?: string; referrer?: string; max_fee?: Coin }): Uint8Array {
   const parts: Uint8Array[] = [];
   parts.push(fldString(1, value.buyer));
   parts.push(concat(tag(2, 0), encodeVarint(BigInt(value.listing_id))));
+  if (value.denom) parts.push(fldString(5, value.denom));
+  if (value.referrer) parts.push(fldString(8, value.referrer));
+  if (value.max_fee) parts.push(fldCoin(9, value.max_fee));
+  return concat(...parts);
+}
+
+// MsgBatchBuy buys every listing in one tx, or none if any purchase fails or the
+// total spent in a denom would exceed max_total. denom picks the price option of an item
+// as in MsgBuyItem.
+export function encodeMsgBatchBuy(value: { buyer: string; items: { listing_id: number; denom?: string }[]; max_total: Coin[] }): Uint8Array {
+  const parts: Uint8Array[] = [];
+  parts.push(fldString(1, value.buyer));
+  for (const it of value.items) {
+    const fields = [concat(tag(1, 0), encodeVarint(BigInt(it.listing_id)))];
+    if (it.denom) fields.push(fldString(4, it.denom));
+    const item = concat(...fields);
+    parts.push(concat(tag(2, 2), encodeVarint(BigInt(item.length)), item));
+  }
+  for (const c of value.max_total) parts.push(fldCoin(3, c));
   return concat(...parts);
 }
 
@@ -113,10 +142,17 @@ const buyType = {
   fromPartial: (v: any) => v,
 } as any;
 
+const batchBuyType = {
+  encode: (v: any) => ({ finish: () => encodeMsgBatchBuy(v) }),
+  decode: (_: Uint8Array) => ({}),
+  fromPartial: (v: any) => v,
+} as any;
+
 export function getRegistry(): Registry {
   const reg = new Registry();
   reg.register(AMP_TYPEURL_LIST, listType);
   reg.register(AMP_TYPEURL_BUY, buyType);
+  reg.register(AMP_TYPEURL_BATCH_BUY, batchBuyType);
   const pointsType = {
     encode: (v: any) => ({ finish: () => encodeMsgRecordActivity(v) }),
     decode: (_: Uint8Array) => ({}),
@@ -195,8 +231,11 @@ export function buildMsgListItem(params: {
   seller: string;
   title?: string;
   description?: string;
-  asset: Coin;
-  price: Coin;
+  asset: Coin[];
+  price: Coin[];
+  referrer?: string;
+  category?: string;
+  tags?: string[];
 }): EncodeObject {
   return {
     typeUrl: AMP_TYPEURL_LIST,
@@ -206,14 +245,24 @@ export function buildMsgListItem(params: {
       description: params.description ?? "",
       asset: params.asset,
       price: params.price,
+      referrer: params.referrer,
+      category: params.category,
+      tags: params.tags,
     },
   };
 }
 
-export function buildMsgBuyItem(params: { buyer: string; listing_id: number }): EncodeObject {
+export function buildMsgBuyItem(params: { buyer: string; listing_id: number; denom?: string; referrer?: string; max_fee?: Coin }): EncodeObject {
   return {
     typeUrl: AMP_TYPEURL_BUY,
-    value: { buyer: params.buyer, listing_id: params.listing_id },
+    value: { buyer: params.buyer, listing_id: params.listing_id, denom: params.denom, referrer: params.referrer, max_fee: params.max_fee },
+  };
+}
+
+export function buildMsgBatchBuy(params: { buyer: string; items: { listing_id: number; denom?: string }[]; max_total: Coin[] }): EncodeObject {
+  return {
+    typeUrl: AMP_TYPEURL_BATCH_BUY,
+    value: { buyer: params.buyer, items: params.items, max_total: params.max_total },
   };
 }
 
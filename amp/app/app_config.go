@@ -85,6 +85,8 @@ var (
 		{Account: nft.ModuleName},
 		{Account: ibctransfertypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
 		{Account: icatypes.ModuleName},
+		{Account: ampmoduletypes.ModuleName, Permissions: []string{authtypes.Burner}},
+		{Account: ampmoduletypes.TreasuryModuleName},
 		// this line is used by starport scaffolding # stargate/app/maccPerms
 	}
 
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+    "context"
+
+    "cosmossdk.io/collections"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+
+    "amp/x/amp/types"
+)
+
+// EndBlocker settles auctions that have reached their end time, returns expired listings
+// to their sellers, refunds expired offers, releases delivery escrow payments whose
+// deadline has passed and resolves disputes that timed out.
+func (k Keeper) EndBlocker(ctx context.Context) error {
+    if err := k.SettleEndedAuctions(ctx); err != nil {
+        return err
+    }
+    if err := k.ExpireListings(ctx); err != nil {
+        return err
+    }
+    if err := k.ExpireOffers(ctx); err != nil {
+        return err
+    }
+    if err := k.ReleaseOverdueDeliveries(ctx); err != nil {
+        return err
+    }
+    return k.ResolveTimedOutDisputes(ctx)
+}
+
+// processDue runs process on the IDs of at most limit entries of a queue keyed by
+// (unix time, id) that are due at or before the current block time, oldest first. Each
+// entry runs in its own cache context so that a failure leaves no partial writes; a failed
+// entry is logged and dropped from the queue, so that it can neither halt the chain nor
+// hold up the entries behind it.
+func (k Keeper) processDue(ctx context.Context, queue collections.KeySet[collections.Pair[int64, uint64]], limit int, what string, process func(ctx context.Context, id uint64) error) error {
+    if limit == 0 {
+        return nil
+    }
+    sdkCtx := sdk.UnwrapSDKContext(ctx)
+    now := sdkCtx.BlockTime().Unix()
+
+    // collect first, processing removes entries from the queue being iterated
+    var keys []collections.Pair[int64, uint64]
+    rng := new(collections.Range[collections.Pair[int64, uint64]]).
+        EndInclusive(collections.Join(now, ^uint64(0)))
+    err := queue.Walk(ctx, rng, func(key collections.Pair[int64, uint64]) (bool, error) {
+        keys = append(keys, key)
+        return len(keys) >= limit, nil
+    })
+    if err != nil {
+        return err
+    }
+
+    for _, key := range keys {
+        cacheCtx, write := sdkCtx.CacheContext()
+        if err := process(cacheCtx, key.K2()); err != nil {
+            sdkCtx.Logger().With("module", "x/"+types.ModuleName).Error("failed to "+what, "id", key.K2(), "err", err)
+            if err := queue.Remove(ctx, key); err != nil {
+                return err
+            }
+            continue
+        }
+        write()
+    }
+    return nil
+}
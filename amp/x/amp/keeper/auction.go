@@ -0,0 +1,267 @@
+package keeper
+
+import (
+    "context"
+    "fmt"
+
+    "cosmossdk.io/collections"
+    errorsmod "cosmossdk.io/errors"
+    sdkmath "cosmossdk.io/math"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+    sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+    authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+    "amp/x/amp/types"
+)
+
+// CreateAuction starts an English auction, locks the seller's asset into escrow and returns its ID.
+func (k Keeper) CreateAuction(ctx context.Context, seller sdk.AccAddress, title, description string, asset, minBid sdk.Coin, endTime int64) (uint64, error) {
+    if err := asset.Validate(); err != nil {
+        return 0, err
+    }
+    if !asset.IsPositive() {
+        return 0, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "asset must be positive")
+    }
+    if err := minBid.Validate(); err != nil {
+        return 0, err
+    }
+
+    sdkCtx := sdk.UnwrapSDKContext(ctx)
+    t := sdkCtx.BlockTime().Unix()
+    if endTime <= t {
+        return 0, types.ErrInvalidEndTime
+    }
+
+    id, err := k.AuctionSeq.Next(ctx)
+    if err != nil {
+        return 0, err
+    }
+
+    sellerStr, _ := k.addressCodec.BytesToString(seller)
+
+    // move asset to escrow
+    escrow := authtypes.NewModuleAddress(types.EscrowModuleName)
+    if err := k.bankKeeper.SendCoins(ctx, seller, escrow, sdk.NewCoins(asset)); err != nil {
+        return 0, err
+    }
+
+    auction := types.Auction{
+        Id:          id,
+        Seller:      sellerStr,
+        Title:       title,
+        Description: description,
+        Asset:       asset,
+        MinBid:      minBid,
+        HighestBid:  sdk.NewCoin(minBid.Denom, sdkmath.ZeroInt()),
+        Status:      types.AuctionStatus_AUCTION_STATUS_ACTIVE,
+        CreatedAt:   t,
+        EndTime:     endTime,
+    }
+
+    if err := k.Auctions.Set(ctx, id, auction); err != nil {
+        return 0, err
+    }
+    if err := k.AuctionsByEnd.Set(ctx, collections.Join(endTime, id)); err != nil {
+        return 0, err
+    }
+
+    _ = sdkCtx.EventManager().EmitTypedEvent(&types.EventAuctionCreated{
+        Id:      id,
+        Seller:  sellerStr,
+        Asset:   asset,
+        MinBid:  minBid,
+        EndTime: endTime,
+    })
+    sdkCtx.EventManager().EmitEvent(
+        sdk.NewEvent(
+            types.EventTypeAuctionCreated,
+            sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", id)),
+            sdk.NewAttribute(types.AttributeKeySeller, sellerStr),
+            sdk.NewAttribute(types.AttributeKeyAsset, asset.String()),
+            sdk.NewAttribute(types.AttributeKeyPrice, minBid.String()),
+            sdk.NewAttribute(types.AttributeKeyEndTime, fmt.Sprintf("%d", endTime)),
+        ),
+    )
+    return id, nil
+}
+
+// PlaceBid locks the bid amount into escrow and refunds the previously highest bidder.
+func (k Keeper) PlaceBid(ctx context.Context, bidder sdk.AccAddress, id uint64, amount sdk.Coin) error {
+    if err := amount.Validate(); err != nil {
+        return err
+    }
+
+    auction, err := k.Auctions.Get(ctx, id)
+    if err != nil {
+        return err
+    }
+    if auction.Status != types.AuctionStatus_AUCTION_STATUS_ACTIVE {
+        return types.ErrAuctionNotActive
+    }
+
+    sdkCtx := sdk.UnwrapSDKContext(ctx)
+    if sdkCtx.BlockTime().Unix() >= auction.EndTime {
+        return types.ErrAuctionEnded
+    }
+
+    bidderStr, _ := k.addressCodec.BytesToString(bidder)
+    if bidderStr == auction.Seller {
+        return types.ErrSelfPurchase
+    }
+
+    if amount.Denom != auction.MinBid.Denom {
+        return errorsmod.Wrapf(types.ErrBidTooLow, "bid denom must be %s", auction.MinBid.Denom)
+    }
+    if amount.Amount.LT(auction.MinBid.Amount) {
+        return errorsmod.Wrapf(types.ErrBidTooLow, "minimum bid is %s", auction.MinBid)
+    }
+    if auction.HighestBidder != "" && !amount.Amount.GT(auction.HighestBid.Amount) {
+        return errorsmod.Wrapf(types.ErrBidTooLow, "bid must exceed %s", auction.HighestBid)
+    }
+
+    // lock the new bid before releasing the previous one
+    escrow := authtypes.NewModuleAddress(types.EscrowModuleName)
+    if err := k.bankKeeper.SendCoins(ctx, bidder, escrow, sdk.NewCoins(amount)); err != nil {
+        return err
+    }
+
+    refundedBidder, refundedAmount := auction.HighestBidder, auction.HighestBid
+    if refundedBidder != "" {
+        prevBz, err := k.addressCodec.StringToBytes(refundedBidder)
+        if err != nil {
+            return err
+        }
+        if err := k.bankKeeper.SendCoins(ctx, escrow, sdk.AccAddress(prevBz), sdk.NewCoins(refundedAmount)); err != nil {
+            return err
+        }
+    }
+
+    auction.HighestBidder = bidderStr
+    auction.HighestBid = amount
+    if err := k.Auctions.Set(ctx, id, auction); err != nil {
+        return err
+    }
+
+    _ = sdkCtx.EventManager().EmitTypedEvent(&types.EventBidPlaced{
+        Id:             id,
+        Bidder:         bidderStr,
+        Amount:         amount,
+        RefundedBidder: refundedBidder,
+        RefundedAmount: refundedAmount,
+    })
+    sdkCtx.EventManager().EmitEvent(
+        sdk.NewEvent(
+            types.EventTypeBidPlaced,
+            sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", id)),
+            sdk.NewAttribute(types.AttributeKeyBidder, bidderStr),
+            sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+        ),
+    )
+    return nil
+}
+
+// SettleAuction closes an auction: the winning bid is paid out from escrow with the same
+// commission and royalty split as BuyItem and the asset goes to the winner, or back to the
+// seller if nobody bid.
+func (k Keeper) SettleAuction(ctx context.Context, id uint64) error {
+    auction, err := k.Auctions.Get(ctx, id)
+    if err != nil {
+        return err
+    }
+    if auction.Status != types.AuctionStatus_AUCTION_STATUS_ACTIVE {
+        return types.ErrAuctionNotActive
+    }
+
+    sellerBz, err := k.addressCodec.StringToBytes(auction.Seller)
+    if err != nil {
+        return err
+    }
+    seller := sdk.AccAddress(sellerBz)
+    escrow := authtypes.NewModuleAddress(types.EscrowModuleName)
+
+    feeCoin := sdk.NewCoin(auction.HighestBid.Denom, sdkmath.ZeroInt())
+    sellerCoin := feeCoin
+    var royalties []types.RoyaltyPayment
+    if auction.HighestBidder == "" {
+        // no bids, return asset to seller
+        if err := k.bankKeeper.SendCoins(ctx, escrow, seller, sdk.NewCoins(auction.Asset)); err != nil {
+            return err
+        }
+        auction.Status = types.AuctionStatus_AUCTION_STATUS_UNSOLD
+    } else {
+        winnerBz, err := k.addressCodec.StringToBytes(auction.HighestBidder)
+        if err != nil {
+            return err
+        }
+        winner := sdk.AccAddress(winnerBz)
+
+        royalties, err = k.saleRoyalties(ctx, auction.HighestBid, sdk.NewCoins(auction.Asset), nil)
+        if err != nil {
+            return err
+        }
+        feeCoin, sellerCoin, _, err = k.settlePayment(ctx, escrow, seller, auction.HighestBid, royalties, nil)
+        if err != nil {
+            return err
+        }
+        if err := k.bankKeeper.SendCoins(ctx, escrow, winner, sdk.NewCoins(auction.Asset)); err != nil {
+            return err
+        }
+        if err := k.recordSale(ctx, auction.HighestBid, feeCoin, sdk.NewCoins(auction.Asset), nil); err != nil {
+            return err
+        }
+        auction.Status = types.AuctionStatus_AUCTION_STATUS_SOLD
+    }
+
+    if err := k.Auctions.Set(ctx, id, auction); err != nil {
+        return err
+    }
+    if err := k.AuctionsByEnd.Remove(ctx, collections.Join(auction.EndTime, id)); err != nil {
+        return err
+    }
+
+    sdkCtx := sdk.UnwrapSDKContext(ctx)
+    _ = sdkCtx.EventManager().EmitTypedEvent(&types.EventAuctionSettled{
+        Id:           id,
+        Seller:       auction.Seller,
+        Winner:       auction.HighestBidder,
+        Asset:        auction.Asset,
+        Price:        auction.HighestBid,
+        Fee:          feeCoin,
+        SellerAmount: sellerCoin,
+        Royalties:    royalties,
+    })
+    sdkCtx.EventManager().EmitEvent(
+        sdk.NewEvent(
+            types.EventTypeAuctionSettled,
+            sdk.NewAttribute(types.AttributeKeyAuctionID, fmt.Sprintf("%d", id)),
+            sdk.NewAttribute(types.AttributeKeySeller, auction.Seller),
+            sdk.NewAttribute(types.AttributeKeyWinner, auction.HighestBidder),
+            sdk.NewAttribute(types.AttributeKeyAsset, auction.Asset.String()),
+            sdk.NewAttribute(types.AttributeKeyPrice, auction.HighestBid.String()),
+            sdk.NewAttribute(types.AttributeKeyFee, feeCoin.String()),
+            sdk.NewAttribute(types.AttributeKeyRoyalty, royaltyTotal(royalties).String()),
+        ),
+    )
+    return nil
+}
+
+// SettleEndedAuctions settles the active auctions whose end time is at or before the current
+// block time, oldest first and at most Params.MaxSettledAuctionsPerBlock per block. An auction
+// whose settlement fails is logged and dropped from the queue, so that it can neither halt
+// the chain nor hold up the auctions behind it; its asset and bid stay in escrow.
+func (k Keeper) SettleEndedAuctions(ctx context.Context) error {
+    params, err := k.Params.Get(ctx)
+    if err != nil {
+        return err
+    }
+    return k.processDue(ctx, k.AuctionsByEnd, int(params.MaxSettledAuctionsPerBlock), "settle auction", k.SettleAuction)
+}
+
+// GetAuction returns an auction by ID and a boolean whether it exists.
+func (k Keeper) GetAuction(ctx context.Context, id uint64) (types.Auction, bool) {
+    auction, err := k.Auctions.Get(ctx, id)
+    if err != nil {
+        return types.Auction{}, false
+    }
+    return auction, true
+}
@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"amp/x/amp/types"
+)
+
+func TestAuctionLifecycle(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	params := types.DefaultParams()
+	params.CommissionRate = sdkmath.LegacyNewDecWithPrec(1, 1)
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	seller := sdk.AccAddress("seller______________")
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 10)))
+	f.bankKeeper.fund(alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 500)))
+	f.bankKeeper.fund(bob, sdk.NewCoins(sdk.NewInt64Coin("stake", 500)))
+
+	id, err := f.keeper.CreateAuction(ctx, seller, "t", "d", sdk.NewInt64Coin("token", 10), sdk.NewInt64Coin("stake", 100), 2000)
+	require.NoError(t, err)
+	require.True(t, f.bankKeeper.balance(seller, "token").IsZero())
+
+	// below minimum, wrong denom and seller bids are rejected
+	require.ErrorIs(t, f.keeper.PlaceBid(ctx, alice, id, sdk.NewInt64Coin("stake", 99)), types.ErrBidTooLow)
+	require.ErrorIs(t, f.keeper.PlaceBid(ctx, alice, id, sdk.NewInt64Coin("token", 100)), types.ErrBidTooLow)
+	require.ErrorIs(t, f.keeper.PlaceBid(ctx, seller, id, sdk.NewInt64Coin("stake", 100)), types.ErrSelfPurchase)
+
+	require.NoError(t, f.keeper.PlaceBid(ctx, alice, id, sdk.NewInt64Coin("stake", 100)))
+	require.Equal(t, int64(400), f.bankKeeper.balance(alice, "stake").Int64())
+
+	// equal bid does not outbid
+	require.ErrorIs(t, f.keeper.PlaceBid(ctx, bob, id, sdk.NewInt64Coin("stake", 100)), types.ErrBidTooLow)
+
+	// outbid bidder is refunded
+	require.NoError(t, f.keeper.PlaceBid(ctx, bob, id, sdk.NewInt64Coin("stake", 200)))
+	require.Equal(t, int64(500), f.bankKeeper.balance(alice, "stake").Int64())
+	require.Equal(t, int64(300), f.bankKeeper.balance(bob, "stake").Int64())
+
+	// nothing happens before the end time
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	auction, found := f.keeper.GetAuction(ctx, id)
+	require.True(t, found)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_ACTIVE, auction.Status)
+
+	ctx = ctx.WithBlockTime(time.Unix(2000, 0))
+	require.ErrorIs(t, f.keeper.PlaceBid(ctx, alice, id, sdk.NewInt64Coin("stake", 300)), types.ErrAuctionEnded)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+
+	auction, found = f.keeper.GetAuction(ctx, id)
+	require.True(t, found)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_SOLD, auction.Status)
+	require.Equal(t, int64(10), f.bankKeeper.balance(bob, "token").Int64())
+	require.Equal(t, int64(180), f.bankKeeper.balance(seller, "stake").Int64())
+	require.Equal(t, int64(20), f.bankKeeper.balance(authtypes.NewModuleAddress(authtypes.FeeCollectorName), "stake").Int64())
+	require.True(t, f.bankKeeper.balance(authtypes.NewModuleAddress(types.EscrowModuleName), "stake").IsZero())
+}
+
+func TestAuctionWithoutBidsReturnsAsset(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	seller := sdk.AccAddress("seller______________")
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 10)))
+
+	_, err := f.keeper.CreateAuction(ctx, seller, "t", "d", sdk.NewInt64Coin("token", 10), sdk.NewInt64Coin("stake", 100), 1000)
+	require.ErrorIs(t, err, types.ErrInvalidEndTime)
+
+	id, err := f.keeper.CreateAuction(ctx, seller, "t", "d", sdk.NewInt64Coin("token", 10), sdk.NewInt64Coin("stake", 100), 1500)
+	require.NoError(t, err)
+
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockTime(time.Unix(1600, 0))))
+
+	auction, found := f.keeper.GetAuction(ctx, id)
+	require.True(t, found)
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_UNSOLD, auction.Status)
+	require.Equal(t, int64(10), f.bankKeeper.balance(seller, "token").Int64())
+}
+
+func TestSettleEndedAuctionsIsCappedAndSkipsFailures(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	params := types.DefaultParams()
+	params.MaxSettledAuctionsPerBlock = 2
+	params.MaxExpiredListingsPerBlock = 0
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	seller := sdk.AccAddress("seller______________")
+	blocked := sdk.AccAddress("blocked_____________")
+	bob := sdk.AccAddress("bob_________________")
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 30)))
+	f.bankKeeper.fund(blocked, sdk.NewCoins(sdk.NewInt64Coin("token", 20)))
+	f.bankKeeper.fund(bob, sdk.NewCoins(sdk.NewInt64Coin("stake", 500)))
+
+	_, err := f.keeper.CreateAuction(ctx, seller, "t", "d", sdk.NewInt64Coin("token", 0), sdk.NewInt64Coin("stake", 100), 1500)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidCoins)
+
+	var ids []uint64
+	for i, owner := range []sdk.AccAddress{blocked, blocked, seller, seller, seller} {
+		id, err := f.keeper.CreateAuction(ctx, owner, "t", "d", sdk.NewInt64Coin("token", 10), sdk.NewInt64Coin("stake", 100), int64(1500+i))
+		require.NoError(t, err)
+		require.NoError(t, f.keeper.PlaceBid(ctx, bob, id, sdk.NewInt64Coin("stake", 100)))
+		ids = append(ids, id)
+	}
+
+	status := func(id uint64) types.AuctionStatus {
+		auction, found := f.keeper.GetAuction(ctx, id)
+		require.True(t, found)
+		return auction.Status
+	}
+
+	// the first seller cannot receive the payment, so those auctions fail without halting the
+	// block, and the auctions cap is independent of the listings cap
+	f.bankKeeper.block(blocked)
+	ctx = ctx.WithBlockTime(time.Unix(2000, 0))
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_ACTIVE, status(ids[0]))
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_ACTIVE, status(ids[1]))
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_ACTIVE, status(ids[2]))
+
+	// the failed auctions left the queue and do not starve the ones behind them
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_SOLD, status(ids[2]))
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_SOLD, status(ids[3]))
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_ACTIVE, status(ids[4]))
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_SOLD, status(ids[4]))
+	require.Equal(t, types.AuctionStatus_AUCTION_STATUS_ACTIVE, status(ids[0]))
+	require.Equal(t, int64(30), f.bankKeeper.balance(bob, "token").Int64())
+}
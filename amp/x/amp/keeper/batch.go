@@ -0,0 +1,87 @@
+package keeper
+
+import (
+    "context"
+
+    errorsmod "cosmossdk.io/errors"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+
+    "amp/x/amp/types"
+)
+
+// BatchBuy buys every item in order with the semantics and events of BuyItem and returns
+// the total the buyer paid. It fails as soon as one purchase fails or would take the running
+// total of a denom over maxTotal; the transaction is then reverted so no item is bought.
+func (k Keeper) BatchBuy(ctx context.Context, buyer sdk.AccAddress, items []types.BatchBuyItem, maxTotal sdk.Coins) (sdk.Coins, error) {
+    if err := maxTotal.Validate(); err != nil {
+        return nil, err
+    }
+    if err := k.checkBatchSize(ctx, len(items)); err != nil {
+        return nil, err
+    }
+
+    total := sdk.NewCoins()
+    for i, item := range items {
+        listing, price, filled, err := k.prepareBuy(ctx, buyer, item.ListingId, types.BuyOptions{
+            Quantity:         item.Quantity,
+            Denom:            item.Denom,
+            Proof:            item.Proof,
+            ExpectedRevision: item.ExpectedRevision,
+            MaxFee:           item.MaxFee,
+        })
+        if err != nil {
+            return nil, errorsmod.Wrapf(err, "item %d (listing %d)", i, item.ListingId)
+        }
+        total = total.Add(price)
+        if !total.IsAllLTE(maxTotal) {
+            return nil, errorsmod.Wrapf(types.ErrMaxTotalExceeded, "spending %s, maximum %s", total, maxTotal)
+        }
+        if err := k.completeSale(ctx, listing, buyer, buyer, price, filled, ""); err != nil {
+            return nil, errorsmod.Wrapf(err, "item %d (listing %d)", i, item.ListingId)
+        }
+    }
+    return total, nil
+}
+
+// BatchList creates a listing for every item in order with the semantics and events of
+// ListItem and returns the new listing IDs.
+func (k Keeper) BatchList(ctx context.Context, seller sdk.AccAddress, items []types.BatchListItem) ([]uint64, error) {
+    if err := k.checkBatchSize(ctx, len(items)); err != nil {
+        return nil, err
+    }
+
+    ids := make([]uint64, 0, len(items))
+    for i, item := range items {
+        id, err := k.ListItem(ctx, seller, types.ListingTerms{
+            Title:          item.Title,
+            Description:    item.Description,
+            Asset:          item.Asset,
+            Price:          item.Price,
+            Dutch:          item.Dutch,
+            ExpiresAt:      item.ExpiresAt,
+            PartialFills:   item.PartialFills,
+            Restriction:    item.Restriction,
+            NFT:            item.Nft,
+            DeliveryEscrow: item.DeliveryEscrow,
+            Category:       item.Category,
+            Tags:           item.Tags,
+        })
+        if err != nil {
+            return nil, errorsmod.Wrapf(err, "item %d", i)
+        }
+        ids = append(ids, id)
+    }
+    return ids, nil
+}
+
+// checkBatchSize rejects empty batches and batches larger than the MaxBatchSize param.
+func (k Keeper) checkBatchSize(ctx context.Context, n int) error {
+    params, err := k.Params.Get(ctx)
+    if err != nil {
+        return err
+    }
+    if n == 0 || n > int(params.MaxBatchSize) {
+        return errorsmod.Wrapf(types.ErrInvalidBatch, "%d items, maximum %d", n, params.MaxBatchSize)
+    }
+    return nil
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"amp/x/amp/types"
+)
+
+func TestBatchListAndBuy(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	params := types.DefaultParams()
+	params.MaxBatchSize = 2
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	seller := sdk.AccAddress("seller______________")
+	buyer := sdk.AccAddress("buyer_______________")
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 30)))
+	f.bankKeeper.fund(buyer, sdk.NewCoins(sdk.NewInt64Coin("stake", 500)))
+
+	item := func(amount, price int64) types.BatchListItem {
+		return types.BatchListItem{
+			Title: "t",
+			Asset: sdk.NewCoins(sdk.NewInt64Coin("token", amount)),
+			Price: sdk.NewCoins(sdk.NewInt64Coin("stake", price)),
+		}
+	}
+	_, err := f.keeper.BatchList(ctx, seller, []types.BatchListItem{item(10, 100), item(10, 100), item(10, 100)})
+	require.ErrorIs(t, err, types.ErrInvalidBatch)
+	_, err = f.keeper.BatchList(ctx, seller, nil)
+	require.ErrorIs(t, err, types.ErrInvalidBatch)
+
+	ids, err := f.keeper.BatchList(ctx, seller, []types.BatchListItem{item(10, 100), item(20, 150)})
+	require.NoError(t, err)
+	require.Len(t, ids, 2)
+	require.True(t, f.bankKeeper.balance(seller, "token").IsZero())
+
+	buys := []types.BatchBuyItem{{ListingId: ids[0]}, {ListingId: ids[1]}}
+
+	// the maximum is checked before paying, and an unlisted denom may not be spent
+	_, err = f.keeper.BatchBuy(ctx, buyer, buys, sdk.NewCoins(sdk.NewInt64Coin("stake", 99)))
+	require.ErrorIs(t, err, types.ErrMaxTotalExceeded)
+	_, err = f.keeper.BatchBuy(ctx, buyer, buys, sdk.NewCoins(sdk.NewInt64Coin("token", 1000)))
+	require.ErrorIs(t, err, types.ErrMaxTotalExceeded)
+	require.Equal(t, int64(500), f.bankKeeper.balance(buyer, "stake").Int64())
+
+	total, err := f.keeper.BatchBuy(ctx, buyer, buys, sdk.NewCoins(sdk.NewInt64Coin("stake", 250)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 250)), total)
+	require.Equal(t, int64(30), f.bankKeeper.balance(buyer, "token").Int64())
+	require.Equal(t, int64(250), f.bankKeeper.balance(buyer, "stake").Int64())
+	for _, id := range ids {
+		listing, _ := f.keeper.GetListing(ctx, id)
+		require.Equal(t, types.ListingStatus_LISTING_STATUS_SOLD, listing.Status)
+	}
+
+	_, err = f.keeper.BatchBuy(ctx, buyer, buys, sdk.NewCoins(sdk.NewInt64Coin("stake", 250)))
+	require.ErrorIs(t, err, types.ErrListingNotActive)
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+    "context"
+
+    "cosmossdk.io/collections"
+    errorsmod "cosmossdk.io/errors"
+
+    "amp/x/amp/types"
+)
+
+// UpdateCategories registers the add categories and removes the remove categories.
+// Removed categories stay on the listings already filed under them. The caller is
+// responsible for checking the module authority.
+func (k Keeper) UpdateCategories(ctx context.Context, add, remove []string) error {
+    for _, category := range add {
+        if err := types.ValidateCategoryName(category); err != nil {
+            return err
+        }
+        if err := k.Categories.Set(ctx, category); err != nil {
+            return err
+        }
+    }
+    for _, category := range remove {
+        if err := k.Categories.Remove(ctx, category); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+// checkTaxonomy checks the category and tags of a new listing. The category is optional
+// but must be registered if set.
+func (k Keeper) checkTaxonomy(ctx context.Context, category string, tags []string) error {
+    if err := types.ValidateTags(tags); err != nil {
+        return err
+    }
+    if category == "" {
+        return nil
+    }
+    ok, err := k.Categories.Has(ctx, category)
+    if err != nil {
+        return err
+    }
+    if !ok {
+        return errorsmod.Wrapf(types.ErrInvalidCategory, "category %s is not registered", category)
+    }
+    return nil
+}
+
+// categoryIndexKeys returns the key an open listing with a category has in the category index.
+func categoryIndexKeys(listing types.Listing) []collections.Pair[string, uint64] {
+    if !listing.IsOpen() || listing.Category == "" {
+        return nil
+    }
+    return []collections.Pair[string, uint64]{collections.Join(listing.Category, listing.Id)}
+}
+
+// tagIndexKeys returns the keys an open listing has in the tag index, one per tag.
+func tagIndexKeys(listing types.Listing) []collections.Pair[string, uint64] {
+    if !listing.IsOpen() {
+        return nil
+    }
+    keys := make([]collections.Pair[string, uint64], 0, len(listing.Tags))
+    for _, tag := range listing.Tags {
+        keys = append(keys, collections.Join(tag, listing.Id))
+    }
+    return keys
+}
@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"amp/x/amp/keeper"
+	"amp/x/amp/types"
+)
+
+func TestCategoriesAndTags(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	seller := sdk.AccAddress("seller______________")
+	buyer := sdk.AccAddress("buyer_______________")
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 40)))
+	f.bankKeeper.fund(buyer, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+
+	_, err = ms.UpdateCategories(ctx, &types.MsgUpdateCategories{Authority: seller.String(), Add: []string{"art"}})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = ms.UpdateCategories(ctx, &types.MsgUpdateCategories{Authority: authority, Add: []string{"Art"}})
+	require.ErrorIs(t, err, types.ErrInvalidCategory)
+	_, err = ms.UpdateCategories(ctx, &types.MsgUpdateCategories{Authority: authority, Add: []string{"art", "games", "tickets"}})
+	require.NoError(t, err)
+	_, err = ms.UpdateCategories(ctx, &types.MsgUpdateCategories{Authority: authority, Remove: []string{"tickets"}})
+	require.NoError(t, err)
+	cats, err := qs.Categories(ctx, &types.QueryCategoriesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{"art", "games"}, cats.Categories)
+
+	list := func(category string, tags []string) (uint64, error) {
+		return f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), Category: category, Tags: tags})
+	}
+	_, err = list("tickets", nil)
+	require.ErrorIs(t, err, types.ErrInvalidCategory)
+	_, err = list("", []string{"rare", "rare"})
+	require.ErrorIs(t, err, types.ErrInvalidTag)
+	_, err = list("", []string{"a", "b", "c", "d", "e", "f"})
+	require.ErrorIs(t, err, types.ErrInvalidTag)
+	_, err = list("", []string{"no spaces"})
+	require.ErrorIs(t, err, types.ErrInvalidTag)
+
+	painting, err := list("art", []string{"rare", "oil"})
+	require.NoError(t, err)
+	poster, err := list("art", []string{"rare"})
+	require.NoError(t, err)
+	game, err := list("games", nil)
+	require.NoError(t, err)
+	untagged, err := list("", nil)
+	require.NoError(t, err)
+	listing, _ := f.keeper.GetListing(ctx, painting)
+	require.Equal(t, "art", listing.Category)
+	require.Equal(t, []string{"rare", "oil"}, listing.Tags)
+
+	ids := func(listings []*types.Listing) []uint64 {
+		out := make([]uint64, 0, len(listings))
+		for _, l := range listings {
+			out = append(out, l.Id)
+		}
+		return out
+	}
+	byCategory := func(category string) []uint64 {
+		res, err := qs.ListingsByCategory(ctx, &types.QueryListingsByCategoryRequest{Category: category})
+		require.NoError(t, err)
+		return ids(res.Listings)
+	}
+	byTag := func(tag string) []uint64 {
+		res, err := qs.ListingsByTag(ctx, &types.QueryListingsByTagRequest{Tag: tag})
+		require.NoError(t, err)
+		return ids(res.Listings)
+	}
+	require.Equal(t, []uint64{painting, poster}, byCategory("art"))
+	require.Equal(t, []uint64{game}, byCategory("games"))
+	require.Equal(t, []uint64{painting, poster}, byTag("rare"))
+	require.Equal(t, []uint64{painting}, byTag("oil"))
+	require.NotContains(t, byCategory("art"), untagged)
+
+	// closed listings leave the indexes
+	require.NoError(t, f.keeper.BuyItem(ctx, buyer, painting, types.BuyOptions{}))
+	require.NoError(t, f.keeper.DelistItem(ctx, seller, game))
+	require.Equal(t, []uint64{poster}, byCategory("art"))
+	require.Empty(t, byCategory("games"))
+	require.Equal(t, []uint64{poster}, byTag("rare"))
+	require.Empty(t, byTag("oil"))
+
+	_, err = qs.ListingsByTag(ctx, &types.QueryListingsByTagRequest{})
+	require.Error(t, err)
+}
@@ -0,0 +1,126 @@
+package keeper
+
+import (
+    "context"
+    "fmt"
+
+    "cosmossdk.io/collections"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+    authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+    "amp/x/amp/types"
+)
+
+// escrowedFee returns the commission on settled, the part of the escrowed payment of a
+// delivery escrow sale that goes to the seller side: the commission quoted at the sale in
+// proportion to settled, so that a change of the commission params while the payment is
+// held cannot exceed the MaxFee the buyer accepted. Sales escrowed before the quote was
+// stored pay the current commission.
+func (k Keeper) escrowedFee(ctx context.Context, listing types.Listing, settled sdk.Coin) (sdk.Coin, error) {
+    quoted, payment := listing.EscrowedFee, listing.EscrowedPayment
+    if quoted.Denom == "" {
+        fee, _, err := k.commission(ctx, settled)
+        return fee, err
+    }
+    if settled.Amount.Equal(payment.Amount) {
+        return quoted, nil
+    }
+    return sdk.NewCoin(settled.Denom, quoted.Amount.Mul(settled.Amount).Quo(payment.Amount)), nil
+}
+
+// ConfirmDelivery releases the escrowed payment of a sale awaiting delivery to the seller,
+// only by the buyer, with the same commission split as BuyItem.
+func (k Keeper) ConfirmDelivery(ctx context.Context, buyer sdk.AccAddress, id uint64) error {
+    listing, err := k.Listings.Get(ctx, id)
+    if err != nil {
+        return err
+    }
+    if listing.Status != types.ListingStatus_LISTING_STATUS_AWAITING_DELIVERY {
+        return types.ErrNotAwaitingDelivery
+    }
+
+    buyerStr, _ := k.addressCodec.BytesToString(buyer)
+    if buyerStr != listing.Buyer {
+        return types.ErrUnauthorized
+    }
+
+    return k.releaseDelivery(ctx, listing, false)
+}
+
+// releaseDelivery pays the escrowed payment of a sale awaiting delivery out to the seller,
+// less the commission quoted at the sale, and marks the listing as completed.
+func (k Keeper) releaseDelivery(ctx context.Context, listing types.Listing, timedOut bool) error {
+    sellerBz, err := k.addressCodec.StringToBytes(listing.Seller)
+    if err != nil {
+        return err
+    }
+
+    escrow := authtypes.NewModuleAddress(types.EscrowModuleName)
+    fee, err := k.escrowedFee(ctx, listing, listing.EscrowedPayment)
+    if err != nil {
+        return err
+    }
+    royalties, err := k.quotedSaleRoyalties(ctx, listing.EscrowedPayment, fee, listing.Asset, listing.Nft)
+    if err != nil {
+        return err
+    }
+    feeCoin, sellerCoin, referrals, err := k.settleQuotedPayment(ctx, escrow, sdk.AccAddress(sellerBz), listing.EscrowedPayment, fee, royalties, listing.Referrers())
+    if err != nil {
+        return err
+    }
+    if err := k.recordSale(ctx, listing.EscrowedPayment, feeCoin, listing.Asset, listing.Nft); err != nil {
+        return err
+    }
+
+    listing.Status = types.ListingStatus_LISTING_STATUS_COMPLETED
+    if err := k.Listings.Set(ctx, listing.Id, listing); err != nil {
+        return err
+    }
+    if err := k.ListingsByDeliveryDeadline.Remove(ctx, collections.Join(listing.DeliveryDeadline, listing.Id)); err != nil {
+        return err
+    }
+
+    sdkCtx := sdk.UnwrapSDKContext(ctx)
+    _ = sdkCtx.EventManager().EmitTypedEvent(&types.EventDeliveryReleased{
+        Id:           listing.Id,
+        Seller:       listing.Seller,
+        Buyer:        listing.Buyer,
+        Price:        listing.EscrowedPayment,
+        Fee:          feeCoin,
+        SellerAmount: sellerCoin,
+        TimedOut:     timedOut,
+        Royalties:    royalties,
+        Referrals:    referrals,
+    })
+    attrs := []sdk.Attribute{
+        sdk.NewAttribute(types.AttributeKeyListingID, fmt.Sprintf("%d", listing.Id)),
+        sdk.NewAttribute(types.AttributeKeySeller, listing.Seller),
+        sdk.NewAttribute(types.AttributeKeyBuyer, listing.Buyer),
+        sdk.NewAttribute(types.AttributeKeyPrice, listing.EscrowedPayment.String()),
+        sdk.NewAttribute(types.AttributeKeyFee, feeCoin.String()),
+        sdk.NewAttribute(types.AttributeKeyRoyalty, royaltyTotal(royalties).String()),
+        sdk.NewAttribute(types.AttributeKeyTimedOut, fmt.Sprintf("%t", timedOut)),
+    }
+    attrs = append(attrs, referralAttributes(referrals)...)
+    sdkCtx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeDeliveryReleased, attrs...))
+    return nil
+}
+
+// ReleaseOverdueDeliveries releases the payment of the sales awaiting delivery whose
+// deadline is at or before the current block time, oldest first and at most
+// Params.MaxReleasedDeliveriesPerBlock per block. A release that fails is logged and dropped
+// from the queue; the payment then stays in escrow until the buyer confirms delivery or an
+// arbiter resolves a dispute.
+func (k Keeper) ReleaseOverdueDeliveries(ctx context.Context) error {
+    params, err := k.Params.Get(ctx)
+    if err != nil {
+        return err
+    }
+    return k.processDue(ctx, k.ListingsByDeliveryDeadline, int(params.MaxReleasedDeliveriesPerBlock), "release delivery", func(ctx context.Context, id uint64) error {
+        listing, err := k.Listings.Get(ctx, id)
+        if err != nil {
+            return err
+        }
+        return k.releaseDelivery(ctx, listing, true)
+    })
+}
@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"amp/x/amp/types"
+)
+
+func TestDeliveryEscrow(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	params := types.DefaultParams()
+	params.CommissionRate = sdkmath.LegacyNewDecWithPrec(1, 1)
+	params.DeliveryTimeout = 500
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	seller := sdk.AccAddress("seller______________")
+	buyer := sdk.AccAddress("buyer_______________")
+	escrow := authtypes.NewModuleAddress(types.EscrowModuleName)
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 20)))
+	f.bankKeeper.fund(buyer, sdk.NewCoins(sdk.NewInt64Coin("stake", 200)))
+
+	list := func() uint64 {
+		id, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), DeliveryEscrow: true})
+		require.NoError(t, err)
+		return id
+	}
+	_, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), PartialFills: true, DeliveryEscrow: true})
+	require.ErrorIs(t, err, types.ErrInvalidQuantity)
+
+	confirmed := list()
+	require.NoError(t, f.keeper.BuyItem(ctx, buyer, confirmed, types.BuyOptions{}))
+	require.Equal(t, int64(10), f.bankKeeper.balance(buyer, "token").Int64())
+	require.Equal(t, int64(100), f.bankKeeper.balance(escrow, "stake").Int64())
+	require.True(t, f.bankKeeper.balance(seller, "stake").IsZero())
+
+	listing, _ := f.keeper.GetListing(ctx, confirmed)
+	require.Equal(t, types.ListingStatus_LISTING_STATUS_AWAITING_DELIVERY, listing.Status)
+	require.Equal(t, int64(1500), listing.DeliveryDeadline)
+
+	require.ErrorIs(t, f.keeper.ConfirmDelivery(ctx, seller, confirmed), types.ErrUnauthorized)
+	require.NoError(t, f.keeper.ConfirmDelivery(ctx, buyer, confirmed))
+	require.ErrorIs(t, f.keeper.ConfirmDelivery(ctx, buyer, confirmed), types.ErrNotAwaitingDelivery)
+	require.Equal(t, int64(90), f.bankKeeper.balance(seller, "stake").Int64())
+	require.Equal(t, int64(10), f.bankKeeper.balance(authtypes.NewModuleAddress(authtypes.FeeCollectorName), "stake").Int64())
+	listing, _ = f.keeper.GetListing(ctx, confirmed)
+	require.Equal(t, types.ListingStatus_LISTING_STATUS_COMPLETED, listing.Status)
+
+	// an unconfirmed sale is released once its deadline passes
+	ctx = ctx.WithBlockTime(time.Unix(1100, 0))
+	overdue := list()
+	require.NoError(t, f.keeper.BuyItem(ctx, buyer, overdue, types.BuyOptions{}))
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockTime(time.Unix(1599, 0))))
+	listing, _ = f.keeper.GetListing(ctx, overdue)
+	require.Equal(t, types.ListingStatus_LISTING_STATUS_AWAITING_DELIVERY, listing.Status)
+
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockTime(time.Unix(1600, 0))))
+	listing, _ = f.keeper.GetListing(ctx, overdue)
+	require.Equal(t, types.ListingStatus_LISTING_STATUS_COMPLETED, listing.Status)
+	require.Equal(t, int64(180), f.bankKeeper.balance(seller, "stake").Int64())
+	require.True(t, f.bankKeeper.balance(escrow, "stake").IsZero())
+}
+
+func TestReleaseOverdueDeliveriesIsCappedAndSkipsFailures(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	params := types.DefaultParams()
+	params.DeliveryTimeout = 500
+	params.MaxReleasedDeliveriesPerBlock = 2
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	seller := sdk.AccAddress("seller______________")
+	blocked := sdk.AccAddress("blocked_____________")
+	buyer := sdk.AccAddress("buyer_______________")
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 30)))
+	f.bankKeeper.fund(blocked, sdk.NewCoins(sdk.NewInt64Coin("token", 10)))
+	f.bankKeeper.fund(buyer, sdk.NewCoins(sdk.NewInt64Coin("stake", 400)))
+
+	var ids []uint64
+	for i, owner := range []sdk.AccAddress{blocked, seller, seller, seller} {
+		id, err := f.keeper.ListItem(ctx.WithBlockTime(time.Unix(int64(1000+i), 0)), owner, types.ListingTerms{Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), DeliveryEscrow: true})
+		require.NoError(t, err)
+		require.NoError(t, f.keeper.BuyItem(ctx.WithBlockTime(time.Unix(int64(1000+i), 0)), buyer, id, types.BuyOptions{}))
+		ids = append(ids, id)
+	}
+
+	status := func(id uint64) types.ListingStatus {
+		listing, found := f.keeper.GetListing(ctx, id)
+		require.True(t, found)
+		return listing.Status
+	}
+
+	// the first seller cannot receive the payment, so that release fails without halting the block
+	f.bankKeeper.block(blocked)
+	ctx = ctx.WithBlockTime(time.Unix(2000, 0))
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	require.Equal(t, types.ListingStatus_LISTING_STATUS_AWAITING_DELIVERY, status(ids[0]))
+	require.Equal(t, types.ListingStatus_LISTING_STATUS_COMPLETED, status(ids[1]))
+	// the last two are over the cap of this block
+	require.Equal(t, types.ListingStatus_LISTING_STATUS_AWAITING_DELIVERY, status(ids[2]))
+	require.Equal(t, types.ListingStatus_LISTING_STATUS_AWAITING_DELIVERY, status(ids[3]))
+
+	// the failed release left the queue and does not hold up the others
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	require.Equal(t, types.ListingStatus_LISTING_STATUS_COMPLETED, status(ids[2]))
+	require.Equal(t, types.ListingStatus_LISTING_STATUS_COMPLETED, status(ids[3]))
+	require.Equal(t, int64(300), f.bankKeeper.balance(seller, "stake").Int64())
+
+	// its payment stays in escrow until the buyer confirms delivery
+	delete(f.bankKeeper.blocked, blocked.String())
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	require.Equal(t, types.ListingStatus_LISTING_STATUS_AWAITING_DELIVERY, status(ids[0]))
+	require.NoError(t, f.keeper.ConfirmDelivery(ctx, buyer, ids[0]))
+	require.Equal(t, int64(100), f.bankKeeper.balance(blocked, "stake").Int64())
+}
+
+func TestDeliveryReleaseTakesTheQuotedCommission(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	params := types.DefaultParams()
+	params.CommissionRate = sdkmath.LegacyNewDecWithPrec(1, 1)
+	params.DeliveryTimeout = 500
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	seller := sdk.AccAddress("seller______________")
+	buyer := sdk.AccAddress("buyer_______________")
+	feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 20)))
+	f.bankKeeper.fund(buyer, sdk.NewCoins(sdk.NewInt64Coin("stake", 200)))
+
+	buy := func() uint64 {
+		id, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), DeliveryEscrow: true})
+		require.NoError(t, err)
+		maxFee := sdk.NewInt64Coin("stake", 10)
+		require.NoError(t, f.keeper.BuyItem(ctx, buyer, id, types.BuyOptions{MaxFee: &maxFee}))
+		return id
+	}
+	confirmed, overdue := buy(), buy()
+	listing, _ := f.keeper.GetListing(ctx, confirmed)
+	require.Equal(t, sdk.NewInt64Coin("stake", 10), listing.EscrowedFee)
+
+	// raising the commission while the payment is held does not exceed the buyer's MaxFee
+	params.CommissionRate = sdkmath.LegacyNewDecWithPrec(5, 1)
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	require.NoError(t, f.keeper.ConfirmDelivery(ctx, buyer, confirmed))
+	require.Equal(t, int64(90), f.bankKeeper.balance(seller, "stake").Int64())
+	require.Equal(t, int64(10), f.bankKeeper.balance(feeCollector, "stake").Int64())
+
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockTime(time.Unix(1500, 0))))
+	listing, _ = f.keeper.GetListing(ctx, overdue)
+	require.Equal(t, types.ListingStatus_LISTING_STATUS_COMPLETED, listing.Status)
+	require.Equal(t, int64(180), f.bankKeeper.balance(seller, "stake").Int64())
+	require.Equal(t, int64(20), f.bankKeeper.balance(feeCollector, "stake").Int64())
+}
@@ -0,0 +1,153 @@
+package keeper
+
+import (
+    "context"
+    "errors"
+    "fmt"
+
+    "cosmossdk.io/collections"
+    errorsmod "cosmossdk.io/errors"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+    authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+    "amp/x/amp/types"
+)
+
+// openListing enforces Params.MaxActiveListingsPerSeller for a new listing of seller, locks
+// Params.ListingDeposit into escrow and counts the listing as active. It returns the
+// locked deposit, nil if there is none.
+func (k Keeper) openListing(ctx context.Context, seller sdk.AccAddress, sellerStr string) (*sdk.Coin, error) {
+    params, err := k.Params.Get(ctx)
+    if err != nil {
+        return nil, err
+    }
+    count, err := k.activeListingCount(ctx, sellerStr)
+    if err != nil {
+        return nil, err
+    }
+    if limit := params.MaxActiveListingsPerSeller; limit != 0 && count >= uint64(limit) {
+        return nil, errorsmod.Wrapf(types.ErrListingLimitReached, "limit is %d", limit)
+    }
+
+    var deposit *sdk.Coin
+    if params.ListingDeposit != nil && params.ListingDeposit.IsPositive() {
+        escrow := authtypes.NewModuleAddress(types.EscrowModuleName)
+        if err := k.bankKeeper.SendCoins(ctx, seller, escrow, sdk.NewCoins(*params.ListingDeposit)); err != nil {
+            return nil, errorsmod.Wrap(types.ErrListingDeposit, err.Error())
+        }
+        locked := *params.ListingDeposit
+        deposit = &locked
+    }
+    return deposit, k.ActiveListingCounts.Set(ctx, sellerStr, count+1)
+}
+
+// settleListingDeposit returns the deposit of a listing that left the active state to its
+// seller if it was sold or delisted, or forfeits it to the community pool if it expired or
+// was taken down, and stops counting the listing as active.
+func (k Keeper) settleListingDeposit(ctx context.Context, listing types.Listing) error {
+    count, err := k.activeListingCount(ctx, listing.Seller)
+    if err != nil {
+        return err
+    }
+    // listings opened before counting began are not counted
+    if count > 1 {
+        err = k.ActiveListingCounts.Set(ctx, listing.Seller, count-1)
+    } else {
+        err = k.ActiveListingCounts.Remove(ctx, listing.Seller)
+    }
+    if err != nil {
+        return err
+    }
+
+    if listing.Deposit == nil || !listing.Deposit.IsPositive() {
+        return nil
+    }
+    escrow := authtypes.NewModuleAddress(types.EscrowModuleName)
+    deposit := sdk.NewCoins(*listing.Deposit)
+    forfeited := listing.Status == types.ListingStatus_LISTING_STATUS_EXPIRED ||
+        listing.Status == types.ListingStatus_LISTING_STATUS_TAKEN_DOWN
+    if forfeited {
+        if err := k.distrKeeper.FundCommunityPool(ctx, deposit, escrow); err != nil {
+            return err
+        }
+    } else {
+        sellerBz, err := k.addressCodec.StringToBytes(listing.Seller)
+        if err != nil {
+            return err
+        }
+        if err := k.bankKeeper.SendCoins(ctx, escrow, sellerBz, deposit); err != nil {
+            return err
+        }
+    }
+
+    sdkCtx := sdk.UnwrapSDKContext(ctx)
+    _ = sdkCtx.EventManager().EmitTypedEvent(&types.EventListingDepositSettled{
+        Id:        listing.Id,
+        Seller:    listing.Seller,
+        Deposit:   *listing.Deposit,
+        Forfeited: forfeited,
+    })
+    sdkCtx.EventManager().EmitEvent(
+        sdk.NewEvent(
+            types.EventTypeListingDepositSettled,
+            sdk.NewAttribute(types.AttributeKeyListingID, fmt.Sprintf("%d", listing.Id)),
+            sdk.NewAttribute(types.AttributeKeySeller, listing.Seller),
+            sdk.NewAttribute(types.AttributeKeyDeposit, listing.Deposit.String()),
+            sdk.NewAttribute(types.AttributeKeyForfeited, fmt.Sprintf("%t", forfeited)),
+        ),
+    )
+    return nil
+}
+
+// activeListingCount returns how many open listings seller has.
+func (k Keeper) activeListingCount(ctx context.Context, seller string) (uint64, error) {
+    count, err := k.ActiveListingCounts.Get(ctx, seller)
+    if errors.Is(err, collections.ErrNotFound) {
+        return 0, nil
+    }
+    return count, err
+}
+
+// TakedownListing closes an open listing on behalf of the module authority: the remaining
+// asset goes back to the seller, open offers are refunded and the deposit is forfeited.
+func (k Keeper) TakedownListing(ctx context.Context, id uint64, reason string) error {
+    listing, err := k.Listings.Get(ctx, id)
+    if err != nil {
+        return err
+    }
+    if !listing.IsOpen() {
+        return types.ErrListingNotActive
+    }
+
+    sellerBz, err := k.addressCodec.StringToBytes(listing.Seller)
+    if err != nil {
+        return err
+    }
+    if err := k.releaseEscrow(ctx, sellerBz, listing.RemainingAssets, listing.Nft); err != nil {
+        return err
+    }
+
+    listing.Status = types.ListingStatus_LISTING_STATUS_TAKEN_DOWN
+    if err := k.closeListing(ctx, listing); err != nil {
+        return err
+    }
+    if err := k.refundOpenOffers(ctx, id); err != nil {
+        return err
+    }
+
+    sdkCtx := sdk.UnwrapSDKContext(ctx)
+    _ = sdkCtx.EventManager().EmitTypedEvent(&types.EventItemTakenDown{
+        Id:     id,
+        Seller: listing.Seller,
+        Reason: reason,
+    })
+    sdkCtx.EventManager().EmitEvent(
+        sdk.NewEvent(
+            types.EventTypeItemTakenDown,
+            sdk.NewAttribute(types.AttributeKeyListingID, fmt.Sprintf("%d", id)),
+            sdk.NewAttribute(types.AttributeKeySeller, listing.Seller),
+            sdk.NewAttribute(types.AttributeKeyReason, reason),
+        ),
+    )
+    return nil
+}
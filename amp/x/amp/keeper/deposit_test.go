@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"amp/x/amp/keeper"
+	"amp/x/amp/types"
+)
+
+func TestListingDeposits(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	deposit := sdk.NewInt64Coin("stake", 5)
+	params := types.DefaultParams()
+	params.ListingDeposit = &deposit
+	params.MaxActiveListingsPerSeller = 2
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	seller := sdk.AccAddress("seller______________")
+	buyer := sdk.AccAddress("buyer_______________")
+	poor := sdk.AccAddress("poor________________")
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 100), sdk.NewInt64Coin("stake", 20)))
+	f.bankKeeper.fund(poor, sdk.NewCoins(sdk.NewInt64Coin("token", 10)))
+	f.bankKeeper.fund(buyer, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+
+	asset, price := sdk.NewCoins(sdk.NewInt64Coin("token", 10)), sdk.NewCoins(sdk.NewInt64Coin("stake", 10))
+	list := func(expiresAt int64) uint64 {
+		id, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: asset, Price: price, ExpiresAt: expiresAt})
+		require.NoError(t, err)
+		return id
+	}
+
+	_, err := f.keeper.ListItem(ctx, poor, types.ListingTerms{Title: "t", Description: "d", Asset: asset, Price: price})
+	require.ErrorIs(t, err, types.ErrListingDeposit)
+
+	sold := list(0)
+	delisted := list(0)
+	_, err = f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: asset, Price: price})
+	require.ErrorIs(t, err, types.ErrListingLimitReached)
+	require.Equal(t, int64(10), f.bankKeeper.balance(seller, "stake").Int64())
+	listing, _ := f.keeper.GetListing(ctx, sold)
+	require.Equal(t, &deposit, listing.Deposit)
+
+	// a sale or a delist refunds the deposit and frees a slot
+	require.NoError(t, f.keeper.BuyItem(ctx, buyer, sold, types.BuyOptions{}))
+	require.Equal(t, int64(25), f.bankKeeper.balance(seller, "stake").Int64())
+	require.NoError(t, f.keeper.DelistItem(ctx, seller, delisted))
+	require.Equal(t, int64(30), f.bankKeeper.balance(seller, "stake").Int64())
+
+	// expiry and takedowns forfeit the deposit to the community pool
+	expiring := list(1100)
+	takenDown := list(0)
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockTime(time.Unix(1100, 0))))
+	listing, _ = f.keeper.GetListing(ctx, expiring)
+	require.Equal(t, types.ListingStatus_LISTING_STATUS_EXPIRED, listing.Status)
+	require.Equal(t, int64(5), f.distrKeeper.communityPool.AmountOf("stake").Int64())
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	_, err = ms.TakedownListing(ctx, &types.MsgTakedownListing{Authority: seller.String(), ListingId: takenDown})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = ms.TakedownListing(ctx, &types.MsgTakedownListing{Authority: authority, ListingId: takenDown, Reason: "spam"})
+	require.NoError(t, err)
+	_, err = ms.TakedownListing(ctx, &types.MsgTakedownListing{Authority: authority, ListingId: takenDown, Reason: "spam"})
+	require.ErrorIs(t, err, types.ErrListingNotActive)
+
+	listing, _ = f.keeper.GetListing(ctx, takenDown)
+	require.Equal(t, types.ListingStatus_LISTING_STATUS_TAKEN_DOWN, listing.Status)
+	require.Equal(t, int64(10), f.distrKeeper.communityPool.AmountOf("stake").Int64())
+	require.Equal(t, int64(90), f.bankKeeper.balance(seller, "token").Int64())
+	require.Equal(t, int64(20), f.bankKeeper.balance(seller, "stake").Int64())
+
+	has, err := f.keeper.ActiveListingCounts.Has(ctx, seller.String())
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -0,0 +1,310 @@
+package keeper
+
+import (
+    "context"
+    "fmt"
+
+    "cosmossdk.io/collections"
+    errorsmod "cosmossdk.io/errors"
+    sdkmath "cosmossdk.io/math"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+    authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+    "amp/x/amp/types"
+)
+
+// OpenDispute freezes the escrowed payment of a sale awaiting delivery, only by its buyer,
+// until an arbiter resolves it or Params.DisputeTimeout passes. The buyer locks
+// Params.DisputeDeposit into escrow, and Params.DisputeSellerBondRate of the escrowed payment
+// is held as the seller's bond.
+func (k Keeper) OpenDispute(ctx context.Context, buyer sdk.AccAddress, listingID uint64, reason, evidenceURI string) error {
+    listing, err := k.Listings.Get(ctx, listingID)
+    if err != nil {
+        return err
+    }
+    if listing.Status != types.ListingStatus_LISTING_STATUS_AWAITING_DELIVERY {
+        return types.ErrNotAwaitingDelivery
+    }
+
+    buyerStr, _ := k.addressCodec.BytesToString(buyer)
+    if buyerStr != listing.Buyer {
+        return types.ErrUnauthorized
+    }
+
+    params, err := k.Params.Get(ctx)
+    if err != nil {
+        return err
+    }
+    deposit := sdk.NewCoin(listing.EscrowedPayment.Denom, sdkmath.ZeroInt())
+    if params.DisputeDeposit != nil {
+        deposit = *params.DisputeDeposit
+    }
+    if deposit.IsPositive() {
+        escrow := authtypes.NewModuleAddress(types.EscrowModuleName)
+        if err := k.bankKeeper.SendCoins(ctx, buyer, escrow, sdk.NewCoins(deposit)); err != nil {
+            return err
+        }
+    }
+
+    bondRate := params.DisputeSellerBondRate
+    if bondRate.IsNil() {
+        bondRate = sdkmath.LegacyZeroDec()
+    }
+    payment := listing.EscrowedPayment
+    sellerBond := sdk.NewCoin(payment.Denom, sdkmath.LegacyNewDecFromInt(payment.Amount).Mul(bondRate).TruncateInt())
+
+    sdkCtx := sdk.UnwrapSDKContext(ctx)
+    now := sdkCtx.BlockTime().Unix()
+    var deadline int64
+    if params.DisputeTimeout != 0 {
+        deadline = now + int64(params.DisputeTimeout)
+        if err := k.DisputesByDeadline.Set(ctx, collections.Join(deadline, listingID)); err != nil {
+            return err
+        }
+    }
+
+    // stop the automatic release while the dispute is open
+    if err := k.ListingsByDeliveryDeadline.Remove(ctx, collections.Join(listing.DeliveryDeadline, listingID)); err != nil {
+        return err
+    }
+    listing.Status = types.ListingStatus_LISTING_STATUS_DISPUTED
+    if err := k.Listings.Set(ctx, listingID, listing); err != nil {
+        return err
+    }
+
+    dispute := types.Dispute{
+        ListingId:    listingID,
+        Buyer:        buyerStr,
+        Seller:       listing.Seller,
+        Reason:       reason,
+        EvidenceUri:  evidenceURI,
+        BuyerDeposit: deposit,
+        Status:       types.DisputeStatus_DISPUTE_STATUS_OPEN,
+        OpenedAt:     now,
+        BuyerRatio:   sdkmath.LegacyZeroDec(),
+        SellerBond:   sellerBond,
+        Deadline:     deadline,
+    }
+    if err := k.Disputes.Set(ctx, listingID, dispute); err != nil {
+        return err
+    }
+
+    _ = sdkCtx.EventManager().EmitTypedEvent(&types.EventDisputeOpened{
+        ListingId:    listingID,
+        Buyer:        buyerStr,
+        Seller:       listing.Seller,
+        Reason:       reason,
+        EvidenceUri:  evidenceURI,
+        BuyerDeposit: deposit,
+        SellerBond:   sellerBond,
+        Deadline:     deadline,
+    })
+    sdkCtx.EventManager().EmitEvent(
+        sdk.NewEvent(
+            types.EventTypeDisputeOpened,
+            sdk.NewAttribute(types.AttributeKeyListingID, fmt.Sprintf("%d", listingID)),
+            sdk.NewAttribute(types.AttributeKeyBuyer, buyerStr),
+            sdk.NewAttribute(types.AttributeKeySeller, listing.Seller),
+            sdk.NewAttribute(types.AttributeKeyReason, reason),
+            sdk.NewAttribute(types.AttributeKeyAmount, deposit.String()),
+            sdk.NewAttribute(types.AttributeKeySellerBond, sellerBond.String()),
+        ),
+    )
+    return nil
+}
+
+// ResolveDispute settles an open dispute, only by an appointed arbiter who is not party to
+// the sale. buyerRatio of the escrowed payment is refunded to the buyer and the rest is
+// paid to the seller with the usual commission. The buyer's deposit is returned unless
+// the buyer is awarded less than half, in which case it is forfeited to the seller; the
+// seller's bond is paid to the buyer out of the seller's share if the buyer is awarded more
+// than half.
+func (k Keeper) ResolveDispute(ctx context.Context, arbiter sdk.AccAddress, listingID uint64, buyerRatio sdkmath.LegacyDec) error {
+    arbiterStr, _ := k.addressCodec.BytesToString(arbiter)
+    ok, err := k.Arbiters.Has(ctx, arbiterStr)
+    if err != nil {
+        return err
+    }
+    if !ok {
+        return types.ErrNotArbiter
+    }
+    if buyerRatio.IsNil() || buyerRatio.IsNegative() || buyerRatio.GT(sdkmath.LegacyOneDec()) {
+        return types.ErrInvalidBuyerRatio
+    }
+
+    dispute, err := k.Disputes.Get(ctx, listingID)
+    if err != nil {
+        return err
+    }
+    if dispute.Status != types.DisputeStatus_DISPUTE_STATUS_OPEN {
+        return types.ErrDisputeNotOpen
+    }
+    if arbiterStr == dispute.Buyer || arbiterStr == dispute.Seller {
+        return errorsmod.Wrap(types.ErrUnauthorized, "arbiter is party to the dispute")
+    }
+
+    return k.resolveDispute(ctx, dispute, arbiterStr, buyerRatio)
+}
+
+// ResolveTimedOutDisputes resolves the open disputes whose deadline is at or before the
+// current block time, oldest first and at most Params.MaxReleasedDeliveriesPerBlock per
+// block. Without a ruling neither party has lost: the payment is split evenly and both the
+// buyer's deposit and the seller's bond are returned. A resolution that fails is logged and
+// dropped from the queue; an arbiter can still resolve the dispute.
+func (k Keeper) ResolveTimedOutDisputes(ctx context.Context) error {
+    params, err := k.Params.Get(ctx)
+    if err != nil {
+        return err
+    }
+    return k.processDue(ctx, k.DisputesByDeadline, int(params.MaxReleasedDeliveriesPerBlock), "resolve timed out dispute", func(ctx context.Context, id uint64) error {
+        dispute, err := k.Disputes.Get(ctx, id)
+        if err != nil {
+            return err
+        }
+        return k.resolveDispute(ctx, dispute, "", sdkmath.LegacyNewDecWithPrec(5, 1))
+    })
+}
+
+// resolveDispute splits the escrowed payment of an open dispute, settles the buyer's deposit
+// and the seller's bond and completes the sale. An empty arbiter means the dispute timed out.
+func (k Keeper) resolveDispute(ctx context.Context, dispute types.Dispute, arbiter string, buyerRatio sdkmath.LegacyDec) error {
+    listingID := dispute.ListingId
+    listing, err := k.Listings.Get(ctx, listingID)
+    if err != nil {
+        return err
+    }
+    buyerBz, err := k.addressCodec.StringToBytes(dispute.Buyer)
+    if err != nil {
+        return err
+    }
+    sellerBz, err := k.addressCodec.StringToBytes(dispute.Seller)
+    if err != nil {
+        return err
+    }
+    buyer, seller := sdk.AccAddress(buyerBz), sdk.AccAddress(sellerBz)
+    escrow := authtypes.NewModuleAddress(types.EscrowModuleName)
+    half := sdkmath.LegacyNewDecWithPrec(5, 1)
+
+    // split the payment; the buyer's share is rounded down
+    payment := listing.EscrowedPayment
+    buyerCoin := sdk.NewCoin(payment.Denom, sdkmath.LegacyNewDecFromInt(payment.Amount).Mul(buyerRatio).TruncateInt())
+    // a losing seller forfeits the bond out of their share
+    bondRecipient := dispute.Seller
+    if buyerRatio.GT(half) && dispute.SellerBond.IsPositive() {
+        bondRecipient = dispute.Buyer
+        buyerCoin = buyerCoin.Add(sdk.NewCoin(payment.Denom, sdkmath.MinInt(dispute.SellerBond.Amount, payment.Amount.Sub(buyerCoin.Amount))))
+    }
+    if buyerCoin.IsPositive() {
+        if err := k.bankKeeper.SendCoins(ctx, escrow, buyer, sdk.NewCoins(buyerCoin)); err != nil {
+            return err
+        }
+    }
+    settled := payment.Sub(buyerCoin)
+    fee, err := k.escrowedFee(ctx, listing, settled)
+    if err != nil {
+        return err
+    }
+    royalties, err := k.quotedSaleRoyalties(ctx, settled, fee, listing.Asset, listing.Nft)
+    if err != nil {
+        return err
+    }
+    feeCoin, sellerCoin, referrals, err := k.settleQuotedPayment(ctx, escrow, seller, settled, fee, royalties, listing.Referrers())
+    if err != nil {
+        return err
+    }
+    // a fully refunded sale never happened, and a partly refunded one says nothing about
+    // the asset's price
+    if settled.IsPositive() {
+        var sold sdk.Coins
+        if buyerCoin.IsZero() {
+            sold = listing.Asset
+        }
+        if err := k.recordSale(ctx, settled, feeCoin, sold, listing.Nft); err != nil {
+            return err
+        }
+    }
+
+    depositRecipient := dispute.Buyer
+    depositTo := buyer
+    if buyerRatio.LT(half) {
+        depositRecipient, depositTo = dispute.Seller, seller
+    }
+    if dispute.BuyerDeposit.IsPositive() {
+        if err := k.bankKeeper.SendCoins(ctx, escrow, depositTo, sdk.NewCoins(dispute.BuyerDeposit)); err != nil {
+            return err
+        }
+    }
+
+    sdkCtx := sdk.UnwrapSDKContext(ctx)
+    timedOut := arbiter == ""
+    dispute.Status = types.DisputeStatus_DISPUTE_STATUS_RESOLVED
+    dispute.Arbiter = arbiter
+    dispute.BuyerRatio = buyerRatio
+    dispute.ResolvedAt = sdkCtx.BlockTime().Unix()
+    dispute.TimedOut = timedOut
+    if err := k.Disputes.Set(ctx, listingID, dispute); err != nil {
+        return err
+    }
+    if dispute.Deadline != 0 {
+        if err := k.DisputesByDeadline.Remove(ctx, collections.Join(dispute.Deadline, listingID)); err != nil {
+            return err
+        }
+    }
+    listing.Status = types.ListingStatus_LISTING_STATUS_COMPLETED
+    if err := k.Listings.Set(ctx, listingID, listing); err != nil {
+        return err
+    }
+
+    _ = sdkCtx.EventManager().EmitTypedEvent(&types.EventDisputeResolved{
+        ListingId:           listingID,
+        Arbiter:             arbiter,
+        BuyerRatio:          buyerRatio,
+        BuyerAmount:         buyerCoin,
+        SellerAmount:        sellerCoin,
+        Fee:                 feeCoin,
+        DepositRecipient:    depositRecipient,
+        Royalties:           royalties,
+        Referrals:           referrals,
+        SellerBondRecipient: bondRecipient,
+        TimedOut:            timedOut,
+    })
+    attrs := []sdk.Attribute{
+        sdk.NewAttribute(types.AttributeKeyListingID, fmt.Sprintf("%d", listingID)),
+        sdk.NewAttribute(types.AttributeKeyArbiter, arbiter),
+        sdk.NewAttribute(types.AttributeKeyBuyerRatio, buyerRatio.String()),
+        sdk.NewAttribute(types.AttributeKeyBuyerAmount, buyerCoin.String()),
+        sdk.NewAttribute(types.AttributeKeyFee, feeCoin.String()),
+        sdk.NewAttribute(types.AttributeKeyTimedOut, fmt.Sprintf("%t", timedOut)),
+    }
+    attrs = append(attrs, referralAttributes(referrals)...)
+    sdkCtx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeDisputeResolved, attrs...))
+    return nil
+}
+
+// UpdateArbiters appoints the add addresses as arbiters and removes the remove addresses.
+// The caller is responsible for checking the module authority.
+func (k Keeper) UpdateArbiters(ctx context.Context, add, remove []string) error {
+    for _, addr := range add {
+        if _, err := k.addressCodec.StringToBytes(addr); err != nil {
+            return errorsmod.Wrapf(err, "invalid arbiter address %s", addr)
+        }
+        if err := k.Arbiters.Set(ctx, addr); err != nil {
+            return err
+        }
+    }
+    for _, addr := range remove {
+        if err := k.Arbiters.Remove(ctx, addr); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+// GetDispute returns the dispute of a listing and a boolean whether it exists.
+func (k Keeper) GetDispute(ctx context.Context, listingID uint64) (types.Dispute, bool) {
+    dispute, err := k.Disputes.Get(ctx, listingID)
+    if err != nil {
+        return types.Dispute{}, false
+    }
+    return dispute, true
+}
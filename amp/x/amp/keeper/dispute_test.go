@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"amp/x/amp/keeper"
+	"amp/x/amp/types"
+)
+
+func TestDisputeResolution(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.CommissionRate = sdkmath.LegacyNewDecWithPrec(1, 1)
+	params.DeliveryTimeout = 500
+	deposit := sdk.NewInt64Coin("stake", 20)
+	params.DisputeDeposit = &deposit
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	seller := sdk.AccAddress("seller______________")
+	buyer := sdk.AccAddress("buyer_______________")
+	arbiter := sdk.AccAddress("arbiter_____________")
+	escrow := authtypes.NewModuleAddress(types.EscrowModuleName)
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 20)))
+	f.bankKeeper.fund(buyer, sdk.NewCoins(sdk.NewInt64Coin("stake", 240)))
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	_, err = ms.UpdateArbiters(ctx, &types.MsgUpdateArbiters{Authority: buyer.String(), Add: []string{arbiter.String()}})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = ms.UpdateArbiters(ctx, &types.MsgUpdateArbiters{Authority: authority, Add: []string{arbiter.String()}})
+	require.NoError(t, err)
+
+	buy := func() uint64 {
+		id, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), DeliveryEscrow: true})
+		require.NoError(t, err)
+		require.NoError(t, f.keeper.BuyItem(ctx, buyer, id, types.BuyOptions{}))
+		return id
+	}
+
+	// the buyer wins most of the payment back, keeps the deposit and takes the seller's bond
+	won := buy()
+	require.ErrorIs(t, f.keeper.OpenDispute(ctx, seller, won, "not delivered", ""), types.ErrUnauthorized)
+	require.NoError(t, f.keeper.OpenDispute(ctx, buyer, won, "not delivered", "ipfs://evidence"))
+	require.Equal(t, int64(120), f.bankKeeper.balance(escrow, "stake").Int64())
+
+	// an open dispute stops the automatic release
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockTime(time.Unix(2000, 0))))
+	listing, _ := f.keeper.GetListing(ctx, won)
+	require.Equal(t, types.ListingStatus_LISTING_STATUS_DISPUTED, listing.Status)
+
+	require.ErrorIs(t, f.keeper.ResolveDispute(ctx, seller, won, sdkmath.LegacyOneDec()), types.ErrNotArbiter)
+	require.ErrorIs(t, f.keeper.ResolveDispute(ctx, arbiter, won, sdkmath.LegacyNewDec(2)), types.ErrInvalidBuyerRatio)
+	require.NoError(t, f.keeper.ResolveDispute(ctx, arbiter, won, sdkmath.LegacyNewDecWithPrec(8, 1)))
+	require.ErrorIs(t, f.keeper.ResolveDispute(ctx, arbiter, won, sdkmath.LegacyOneDec()), types.ErrDisputeNotOpen)
+
+	// 80 refunded plus the seller's 10 bond and the 20 deposit; seller gets 10 less 10% commission
+	require.Equal(t, int64(230), f.bankKeeper.balance(buyer, "stake").Int64())
+	require.Equal(t, int64(9), f.bankKeeper.balance(seller, "stake").Int64())
+	dispute, found := f.keeper.GetDispute(ctx, won)
+	require.True(t, found)
+	require.Equal(t, types.DisputeStatus_DISPUTE_STATUS_RESOLVED, dispute.Status)
+	require.Equal(t, arbiter.String(), dispute.Arbiter)
+	require.Equal(t, deposit, dispute.BuyerDeposit)
+	require.Equal(t, sdk.NewInt64Coin("stake", 10), dispute.SellerBond)
+
+	// the losing buyer forfeits the deposit to the seller, who keeps the bond
+	lost := buy()
+	require.NoError(t, f.keeper.OpenDispute(ctx, buyer, lost, "changed my mind", ""))
+	require.NoError(t, f.keeper.ResolveDispute(ctx, arbiter, lost, sdkmath.LegacyZeroDec()))
+	require.Equal(t, int64(110), f.bankKeeper.balance(buyer, "stake").Int64())
+	require.Equal(t, int64(119), f.bankKeeper.balance(seller, "stake").Int64())
+	require.True(t, f.bankKeeper.balance(escrow, "stake").IsZero())
+	listing, _ = f.keeper.GetListing(ctx, lost)
+	require.Equal(t, types.ListingStatus_LISTING_STATUS_COMPLETED, listing.Status)
+}
+
+func TestDisputeTimeout(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	params := types.DefaultParams()
+	params.DeliveryTimeout = 500
+	params.DisputeTimeout = 1000
+	deposit := sdk.NewInt64Coin("stake", 20)
+	params.DisputeDeposit = &deposit
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	seller := sdk.AccAddress("seller______________")
+	buyer := sdk.AccAddress("buyer_______________")
+	escrow := authtypes.NewModuleAddress(types.EscrowModuleName)
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 10)))
+	f.bankKeeper.fund(buyer, sdk.NewCoins(sdk.NewInt64Coin("stake", 120)))
+
+	id, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), DeliveryEscrow: true})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.BuyItem(ctx, buyer, id, types.BuyOptions{}))
+	require.NoError(t, f.keeper.OpenDispute(ctx, buyer, id, "not delivered", ""))
+	dispute, _ := f.keeper.GetDispute(ctx, id)
+	require.Equal(t, int64(2000), dispute.Deadline)
+
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockTime(time.Unix(1999, 0))))
+	dispute, _ = f.keeper.GetDispute(ctx, id)
+	require.Equal(t, types.DisputeStatus_DISPUTE_STATUS_OPEN, dispute.Status)
+
+	// no arbiter ruled: the payment is split evenly and the deposit and bond are returned
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockTime(time.Unix(2000, 0))))
+	dispute, _ = f.keeper.GetDispute(ctx, id)
+	require.Equal(t, types.DisputeStatus_DISPUTE_STATUS_RESOLVED, dispute.Status)
+	require.True(t, dispute.TimedOut)
+	require.Empty(t, dispute.Arbiter)
+	require.Equal(t, int64(70), f.bankKeeper.balance(buyer, "stake").Int64())
+	require.Equal(t, int64(50), f.bankKeeper.balance(seller, "stake").Int64())
+	require.True(t, f.bankKeeper.balance(escrow, "stake").IsZero())
+	listing, _ := f.keeper.GetListing(ctx, id)
+	require.Equal(t, types.ListingStatus_LISTING_STATUS_COMPLETED, listing.Status)
+}
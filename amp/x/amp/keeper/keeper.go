@@ -4,6 +4,7 @@ import (
     "fmt"
 
     "cosmossdk.io/collections"
+    "cosmossdk.io/collections/indexes"
     "cosmossdk.io/core/address"
     corestore "cosmossdk.io/core/store"
     "github.com/cosmos/cosmos-sdk/codec"
@@ -11,6 +12,36 @@ import (
     "amp/x/amp/types"
 )
 
+// ListingIndexes are the secondary indexes of Keeper.Listings.
+type ListingIndexes struct {
+    Seller *indexes.Multi[string, uint64, types.Listing]
+    Status *indexes.Multi[int32, uint64, types.Listing]
+    // Price indexes open listings by unit price, and Category and Tags open listings by
+    // (category or tag, listing id).
+    Price    *ListingKeysIndex[PriceIndexKey]
+    Category *ListingKeysIndex[collections.Pair[string, uint64]]
+    Tags     *ListingKeysIndex[collections.Pair[string, uint64]]
+}
+
+func (i ListingIndexes) IndexesList() []collections.Index[uint64, types.Listing] {
+    return []collections.Index[uint64, types.Listing]{i.Seller, i.Status, i.Price, i.Category, i.Tags}
+}
+
+func newListingIndexes(sb *collections.SchemaBuilder) ListingIndexes {
+    return ListingIndexes{
+        Seller: indexes.NewMulti(sb, types.ListingsBySellerPrefix, "listings_by_seller", collections.StringKey, collections.Uint64Key,
+            func(_ uint64, listing types.Listing) (string, error) { return listing.Seller, nil }),
+        Status: indexes.NewMulti(sb, types.ListingsByStatusPrefix, "listings_by_status", collections.Int32Key, collections.Uint64Key,
+            func(_ uint64, listing types.Listing) (int32, error) { return int32(listing.Status), nil }),
+        Price: newListingKeysIndex(sb, types.ListingsByPricePrefix, "listings_by_price",
+            collections.QuadKeyCodec(collections.StringKey, collections.StringKey, types.LegacyDecKey, collections.Uint64Key), priceIndexKeys),
+        Category: newListingKeysIndex(sb, types.ListingsByCategoryPrefix, "listings_by_category",
+            collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), categoryIndexKeys),
+        Tags: newListingKeysIndex(sb, types.ListingsByTagPrefix, "listings_by_tag",
+            collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), tagIndexKeys),
+    }
+}
+
 type Keeper struct {
     storeService corestore.KVStoreService
     cdc          codec.Codec
@@ -23,11 +54,56 @@ type Keeper struct {
     Params collections.Item[types.Params]
 
     // external keepers
-    bankKeeper types.BankKeeper
+    bankKeeper  types.BankKeeper
+    nftKeeper   types.NFTKeeper
+    distrKeeper types.DistrKeeper
 
     // state
-    Listings   collections.Map[uint64, types.Listing]
-    ListingSeq collections.Sequence
+    Listings           *collections.IndexedMap[uint64, types.Listing, ListingIndexes]
+    ListingSeq         collections.Sequence
+    ListingsByExpiry   collections.KeySet[collections.Pair[int64, uint64]]
+    PriceHistory       collections.Map[collections.Pair[uint64, uint64], types.PriceChange]
+    ListingAllowlist   collections.KeySet[collections.Pair[uint64, string]]
+    ListingsByNFTClass collections.KeySet[collections.Pair[string, uint64]]
+    // ListingsByBuyer holds every buyer of a listing, unlike Listing.Buyer, which only
+    // holds the last buyer of a partially filled listing.
+    ListingsByBuyer collections.KeySet[collections.Pair[string, uint64]]
+
+    ActiveListingCounts collections.Map[string, uint64]
+
+    ListingsByDeliveryDeadline collections.KeySet[collections.Pair[int64, uint64]]
+
+    Disputes           collections.Map[uint64, types.Dispute]
+    DisputesByDeadline collections.KeySet[collections.Pair[int64, uint64]]
+    Arbiters           collections.KeySet[string]
+
+    Auctions      collections.Map[uint64, types.Auction]
+    AuctionSeq    collections.Sequence
+    AuctionsByEnd collections.KeySet[collections.Pair[int64, uint64]]
+
+    Offers          collections.Map[uint64, types.Offer]
+    OfferSeq        collections.Sequence
+    OffersByListing collections.KeySet[collections.Pair[uint64, uint64]]
+    OffersByBuyer   collections.KeySet[collections.Pair[string, uint64]]
+    OffersByExpiry  collections.KeySet[collections.Pair[int64, uint64]]
+
+    Orders        collections.Map[uint64, types.Order]
+    OrderSeq      collections.Sequence
+    OrderBookBids collections.KeySet[collections.Triple[string, uint64, uint64]]
+    OrderBookAsks collections.KeySet[collections.Triple[string, uint64, uint64]]
+
+    Royalties collections.Map[collections.Pair[int32, string], types.Royalty]
+    Creators  collections.Map[collections.Pair[int32, string], string]
+
+    ReferrerStats collections.Map[string, types.ReferrerStats]
+
+    Categories collections.KeySet[string]
+
+    DenomStats     collections.Map[string, types.DenomStats]
+    AssetStats     collections.Map[collections.Pair[string, string], types.AssetStats]
+    StatsSnapshots collections.Map[int64, types.MarketStats]
+
+    TwapRecords collections.Map[collections.Triple[string, string, int64], types.TwapRecord]
 }
 
 func NewKeeper(
@@ -36,7 +112,8 @@ func NewKeeper(
     addressCodec address.Codec,
     authority []byte,
     bankKeeper types.BankKeeper,
-
+    nftKeeper types.NFTKeeper,
+    distrKeeper types.DistrKeeper,
 ) Keeper {
     if _, err := addressCodec.BytesToString(authority); err != nil {
         panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -50,11 +127,54 @@ func NewKeeper(
         addressCodec: addressCodec,
         authority:    authority,
 
-        bankKeeper: bankKeeper,
+        bankKeeper:  bankKeeper,
+        nftKeeper:   nftKeeper,
+        distrKeeper: distrKeeper,
 
         Params:     collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-        Listings:   collections.NewMap(sb, types.ListingsPrefix, "listings", collections.Uint64Key, codec.CollValue[types.Listing](cdc)),
-        ListingSeq: collections.NewSequence(sb, types.ListingSeqKey, "listing_seq"),
+        Listings:           collections.NewIndexedMap(sb, types.ListingsPrefix, "listings", collections.Uint64Key, codec.CollValue[types.Listing](cdc), newListingIndexes(sb)),
+        ListingSeq:         collections.NewSequence(sb, types.ListingSeqKey, "listing_seq"),
+        ListingsByExpiry:   collections.NewKeySet(sb, types.ListingsByExpiryPrefix, "listings_by_expiry", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+        PriceHistory:       collections.NewMap(sb, types.PriceHistoryPrefix, "price_history", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key), codec.CollValue[types.PriceChange](cdc)),
+        ListingAllowlist:   collections.NewKeySet(sb, types.ListingAllowlistPrefix, "listing_allowlist", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey)),
+        ListingsByNFTClass: collections.NewKeySet(sb, types.ListingsByNFTClassPrefix, "listings_by_nft_class", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+        ListingsByBuyer:    collections.NewKeySet(sb, types.ListingsByBuyerPrefix, "listings_by_buyer", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+
+        ActiveListingCounts: collections.NewMap(sb, types.ActiveListingCountsPrefix, "active_listing_counts", collections.StringKey, collections.Uint64Value),
+
+        ListingsByDeliveryDeadline: collections.NewKeySet(sb, types.ListingsByDeliveryDeadlinePrefix, "listings_by_delivery_deadline", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+
+        Disputes:           collections.NewMap(sb, types.DisputesPrefix, "disputes", collections.Uint64Key, codec.CollValue[types.Dispute](cdc)),
+        DisputesByDeadline: collections.NewKeySet(sb, types.DisputesByDeadlinePrefix, "disputes_by_deadline", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+        Arbiters:           collections.NewKeySet(sb, types.ArbitersPrefix, "arbiters", collections.StringKey),
+
+        Auctions:      collections.NewMap(sb, types.AuctionsPrefix, "auctions", collections.Uint64Key, codec.CollValue[types.Auction](cdc)),
+        AuctionSeq:    collections.NewSequence(sb, types.AuctionSeqKey, "auction_seq"),
+        AuctionsByEnd: collections.NewKeySet(sb, types.AuctionsByEndPrefix, "auctions_by_end", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+
+        Offers:          collections.NewMap(sb, types.OffersPrefix, "offers", collections.Uint64Key, codec.CollValue[types.Offer](cdc)),
+        OfferSeq:        collections.NewSequence(sb, types.OfferSeqKey, "offer_seq"),
+        OffersByListing: collections.NewKeySet(sb, types.OffersByListingPrefix, "offers_by_listing", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+        OffersByBuyer:   collections.NewKeySet(sb, types.OffersByBuyerPrefix, "offers_by_buyer", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+        OffersByExpiry:  collections.NewKeySet(sb, types.OffersByExpiryPrefix, "offers_by_expiry", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+
+        Orders:        collections.NewMap(sb, types.OrdersPrefix, "orders", collections.Uint64Key, codec.CollValue[types.Order](cdc)),
+        OrderSeq:      collections.NewSequence(sb, types.OrderSeqKey, "order_seq"),
+        OrderBookBids: collections.NewKeySet(sb, types.OrderBookBidsPrefix, "order_book_bids", collections.TripleKeyCodec(collections.StringKey, collections.Uint64Key, collections.Uint64Key)),
+        OrderBookAsks: collections.NewKeySet(sb, types.OrderBookAsksPrefix, "order_book_asks", collections.TripleKeyCodec(collections.StringKey, collections.Uint64Key, collections.Uint64Key)),
+
+        Royalties: collections.NewMap(sb, types.RoyaltiesPrefix, "royalties", collections.PairKeyCodec(collections.Int32Key, collections.StringKey), codec.CollValue[types.Royalty](cdc)),
+        Creators:  collections.NewMap(sb, types.CreatorsPrefix, "creators", collections.PairKeyCodec(collections.Int32Key, collections.StringKey), collections.StringValue),
+
+        ReferrerStats: collections.NewMap(sb, types.ReferrerStatsPrefix, "referrer_stats", collections.StringKey, codec.CollValue[types.ReferrerStats](cdc)),
+
+        Categories: collections.NewKeySet(sb, types.CategoriesPrefix, "categories", collections.StringKey),
+
+        DenomStats:     collections.NewMap(sb, types.DenomStatsPrefix, "denom_stats", collections.StringKey, codec.CollValue[types.DenomStats](cdc)),
+        AssetStats:     collections.NewMap(sb, types.AssetStatsPrefix, "asset_stats", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.AssetStats](cdc)),
+        StatsSnapshots: collections.NewMap(sb, types.StatsSnapshotsPrefix, "stats_snapshots", collections.Int64Key, codec.CollValue[types.MarketStats](cdc)),
+
+        TwapRecords: collections.NewMap(sb, types.TwapRecordsPrefix, "twap_records", collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.Int64Key), codec.CollValue[types.TwapRecord](cdc)),
     }
 
 	schema, err := sb.Build()
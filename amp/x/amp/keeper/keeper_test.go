@@ -2,9 +2,11 @@ package keeper_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"cosmossdk.io/core/address"
+	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -22,6 +24,108 @@ type fixture struct {
 	ctx          context.Context
 	keeper       keeper.Keeper
 	addressCodec address.Codec
+	bankKeeper   *mockBankKeeper
+	nftKeeper    *mockNFTKeeper
+	distrKeeper  *mockDistrKeeper
+}
+
+// mockBankKeeper is an in-memory BankKeeper that only tracks balances, and rejects sends
+// to blocked addresses.
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+	burned   sdk.Coins
+	blocked  map[string]bool
+}
+
+func newMockBankKeeper() *mockBankKeeper {
+	return &mockBankKeeper{balances: make(map[string]sdk.Coins), blocked: make(map[string]bool)}
+}
+
+func (b *mockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.balances[addr.String()]
+}
+
+func (b *mockBankKeeper) SendCoins(_ context.Context, from, to sdk.AccAddress, amt sdk.Coins) error {
+	if b.blocked[to.String()] {
+		return fmt.Errorf("%s is not allowed to receive funds", to)
+	}
+	bal, neg := b.balances[from.String()].SafeSub(amt...)
+	if neg {
+		return fmt.Errorf("insufficient funds: %s < %s", b.balances[from.String()], amt)
+	}
+	b.balances[from.String()] = bal
+	b.balances[to.String()] = b.balances[to.String()].Add(amt...)
+	return nil
+}
+
+func (b *mockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, from sdk.AccAddress, module string, amt sdk.Coins) error {
+	return b.SendCoins(ctx, from, authtypes.NewModuleAddress(module), amt)
+}
+
+func (b *mockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, module string, to sdk.AccAddress, amt sdk.Coins) error {
+	return b.SendCoins(ctx, authtypes.NewModuleAddress(module), to, amt)
+}
+
+func (b *mockBankKeeper) BurnCoins(_ context.Context, module string, amt sdk.Coins) error {
+	addr := authtypes.NewModuleAddress(module).String()
+	bal, neg := b.balances[addr].SafeSub(amt...)
+	if neg {
+		return fmt.Errorf("insufficient funds: %s < %s", b.balances[addr], amt)
+	}
+	b.balances[addr] = bal
+	b.burned = b.burned.Add(amt...)
+	return nil
+}
+
+func (b *mockBankKeeper) fund(addr sdk.AccAddress, amt sdk.Coins) {
+	b.balances[addr.String()] = b.balances[addr.String()].Add(amt...)
+}
+
+func (b *mockBankKeeper) block(addr sdk.AccAddress) {
+	b.blocked[addr.String()] = true
+}
+
+func (b *mockBankKeeper) balance(addr sdk.AccAddress, denom string) sdkmath.Int {
+	return b.balances[addr.String()].AmountOf(denom)
+}
+
+// mockDistrKeeper is an in-memory DistrKeeper that only tracks the community pool.
+type mockDistrKeeper struct {
+	bank          *mockBankKeeper
+	communityPool sdk.Coins
+}
+
+func (d *mockDistrKeeper) FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	if err := d.bank.SendCoins(ctx, sender, authtypes.NewModuleAddress("distribution"), amount); err != nil {
+		return err
+	}
+	d.communityPool = d.communityPool.Add(amount...)
+	return nil
+}
+
+// mockNFTKeeper is an in-memory NFTKeeper that only tracks owners.
+type mockNFTKeeper struct {
+	owners map[string]sdk.AccAddress
+}
+
+func newMockNFTKeeper() *mockNFTKeeper {
+	return &mockNFTKeeper{owners: make(map[string]sdk.AccAddress)}
+}
+
+func (n *mockNFTKeeper) GetOwner(_ context.Context, classID, nftID string) sdk.AccAddress {
+	return n.owners[classID+"/"+nftID]
+}
+
+func (n *mockNFTKeeper) Transfer(_ context.Context, classID, nftID string, receiver sdk.AccAddress) error {
+	if _, ok := n.owners[classID+"/"+nftID]; !ok {
+		return fmt.Errorf("nft %s/%s does not exist", classID, nftID)
+	}
+	n.owners[classID+"/"+nftID] = receiver
+	return nil
+}
+
+func (n *mockNFTKeeper) mint(classID, nftID string, owner sdk.AccAddress) {
+	n.owners[classID+"/"+nftID] = owner
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -35,12 +139,18 @@ func initFixture(t *testing.T) *fixture {
 	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	bankKeeper := newMockBankKeeper()
+	nftKeeper := newMockNFTKeeper()
+	distrKeeper := &mockDistrKeeper{bank: bankKeeper}
 
 	k := keeper.NewKeeper(
 		storeService,
 		encCfg.Codec,
 		addressCodec,
 		authority,
+		bankKeeper,
+		nftKeeper,
+		distrKeeper,
 	)
 
 	// Initialize params
@@ -52,5 +162,8 @@ func initFixture(t *testing.T) *fixture {
 		ctx:          ctx,
 		keeper:       k,
 		addressCodec: addressCodec,
+		bankKeeper:   bankKeeper,
+		nftKeeper:    nftKeeper,
+		distrKeeper:  distrKeeper,
 	}
 }
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+    "context"
+    "errors"
+
+    "cosmossdk.io/collections"
+    collcodec "cosmossdk.io/collections/codec"
+
+    "amp/x/amp/types"
+)
+
+// ListingKeysIndex indexes every listing under the keys that keyFunc returns for it, of
+// which there may be any number. As one of ListingIndexes it is kept up to date on every
+// write to Keeper.Listings.
+type ListingKeysIndex[K any] struct {
+    refKeys collections.KeySet[K]
+    keyFunc func(types.Listing) []K
+}
+
+func newListingKeysIndex[K any](sb *collections.SchemaBuilder, prefix collections.Prefix, name string, keyCodec collcodec.KeyCodec[K], keyFunc func(types.Listing) []K) *ListingKeysIndex[K] {
+    return &ListingKeysIndex[K]{
+        refKeys: collections.NewKeySet(sb, prefix, name, keyCodec),
+        keyFunc: keyFunc,
+    }
+}
+
+func (i *ListingKeysIndex[K]) Reference(ctx context.Context, pk uint64, newValue types.Listing, lazyOldValue func() (types.Listing, error)) error {
+    if err := i.Unreference(ctx, pk, lazyOldValue); err != nil {
+        return err
+    }
+    for _, key := range i.keyFunc(newValue) {
+        if err := i.refKeys.Set(ctx, key); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+func (i *ListingKeysIndex[K]) Unreference(ctx context.Context, _ uint64, getValue func() (types.Listing, error)) error {
+    oldValue, err := getValue()
+    if errors.Is(err, collections.ErrNotFound) {
+        return nil
+    }
+    if err != nil {
+        return err
+    }
+    for _, key := range i.keyFunc(oldValue) {
+        if err := i.refKeys.Remove(ctx, key); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+// Iterate iterates over the index keys in ranger.
+func (i *ListingKeysIndex[K]) Iterate(ctx context.Context, ranger collections.Ranger[K]) (collections.KeySetIterator[K], error) {
+    return i.refKeys.Iterate(ctx, ranger)
+}
+
+// IterateRaw iterates over the index keys between the raw start and end keys, which lets
+// the index be paginated with query.CollectionPaginate.
+func (i *ListingKeysIndex[K]) IterateRaw(ctx context.Context, start, end []byte, order collections.Order) (collections.Iterator[K, collections.NoValue], error) {
+    return i.refKeys.IterateRaw(ctx, start, end, order)
+}
+
+// KeyCodec returns the key codec of the index.
+func (i *ListingKeysIndex[K]) KeyCodec() collcodec.KeyCodec[K] {
+    return i.refKeys.KeyCodec()
+}
@@ -8,18 +8,35 @@ import (
     sdkmath "cosmossdk.io/math"
     authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
     "cosmossdk.io/collections"
+    errorsmod "cosmossdk.io/errors"
 
     "amp/x/amp/types"
 )
 
-// ListItem creates a new listing, locks the seller's asset into escrow and returns its ID.
-func (k Keeper) ListItem(ctx context.Context, seller sdk.AccAddress, title, description string, asset, price sdk.Coin) (uint64, error) {
-    if err := asset.Validate(); err != nil {
+// ListItem creates a new listing on terms, locks the seller's asset bundle or NFT into
+// escrow and returns its ID. The seller locks Params.ListingDeposit and may have at most
+// Params.MaxActiveListingsPerSeller open listings.
+func (k Keeper) ListItem(ctx context.Context, seller sdk.AccAddress, terms types.ListingTerms) (uint64, error) {
+    err := types.ValidateListingTerms(terms.Asset, terms.Price, terms.Dutch, terms.PartialFills, terms.NFT)
+    if err != nil {
+        return 0, err
+    }
+    if err := k.checkTaxonomy(ctx, terms.Category, terms.Tags); err != nil {
         return 0, err
     }
-    if err := price.Validate(); err != nil {
+    referrerStr, err := k.referrerString(seller, terms.Referrer)
+    if err != nil {
         return 0, err
     }
+    if terms.DeliveryEscrow && terms.PartialFills {
+        return 0, errorsmod.Wrap(types.ErrInvalidQuantity, "delivery escrow listings cannot be partially filled")
+    }
+    var access types.ListingAccess
+    if terms.Restriction != nil {
+        if access, err = terms.Restriction.Access(); err != nil {
+            return 0, err
+        }
+    }
 
     id, err := k.ListingSeq.Next(ctx)
     if err != nil {
@@ -27,150 +44,434 @@ func (k Keeper) ListItem(ctx context.Context, seller sdk.AccAddress, title, desc
     }
 
     // record creation time based on block time
-    t := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+    sdkCtx := sdk.UnwrapSDKContext(ctx)
+    t := sdkCtx.BlockTime().Unix()
+    if terms.ExpiresAt != 0 && terms.ExpiresAt <= t {
+        return 0, types.ErrInvalidExpiry
+    }
 
     sellerStr, _ := k.addressCodec.BytesToString(seller)
+    deposit, err := k.openListing(ctx, seller, sellerStr)
+    if err != nil {
+        return 0, err
+    }
 
     // move asset to escrow
     escrow := authtypes.NewModuleAddress(types.EscrowModuleName)
-    if err := k.bankKeeper.SendCoins(ctx, seller, escrow, sdk.NewCoins(asset)); err != nil {
+    nft := terms.NFT
+    if nft != nil {
+        if !seller.Equals(k.nftKeeper.GetOwner(ctx, nft.ClassId, nft.NftId)) {
+            return 0, errorsmod.Wrapf(types.ErrUnauthorized, "seller does not own nft %s/%s", nft.ClassId, nft.NftId)
+        }
+        if err := k.nftKeeper.Transfer(ctx, nft.ClassId, nft.NftId, escrow); err != nil {
+            return 0, err
+        }
+    } else if err := k.bankKeeper.SendCoins(ctx, seller, escrow, terms.Asset); err != nil {
         return 0, err
     }
 
     listing := types.Listing{
-        Id:          id,
-        Seller:      sellerStr,
-        Title:       title,
-        Description: description,
-        Asset:       asset,
-        Price:       price,
-        Status:      types.ListingStatus_LISTING_STATUS_ACTIVE,
-        Buyer:       "",
-        CreatedAt:   t,
+        Id:              id,
+        Seller:          sellerStr,
+        Title:           terms.Title,
+        Description:     terms.Description,
+        Asset:           terms.Asset,
+        Price:           terms.Price,
+        Status:          types.ListingStatus_LISTING_STATUS_ACTIVE,
+        Buyer:           "",
+        CreatedAt:       t,
+        ExpiresAt:       terms.ExpiresAt,
+        PartialFills:    terms.PartialFills,
+        RemainingAssets: terms.Asset,
+        Access:          access,
+        Nft:             nft,
+        DeliveryEscrow:  terms.DeliveryEscrow,
+        Referrer:        referrerStr,
+        Deposit:         deposit,
+        Category:        terms.Category,
+        Tags:            terms.Tags,
+    }
+    if access == types.ListingAccess_LISTING_ACCESS_MERKLE {
+        listing.MerkleRoot = terms.Restriction.MerkleRoot
+    }
+    if dutch := terms.Dutch; dutch != nil {
+        listing.Dutch = &types.DutchAuction{
+            FloorPrice:  dutch.FloorPrice,
+            Unit:        dutch.Unit,
+            Duration:    dutch.Duration,
+            StartHeight: sdkCtx.BlockHeight(),
+            StartTime:   t,
+        }
     }
 
     if err := k.Listings.Set(ctx, id, listing); err != nil {
         return 0, err
     }
+    if err := k.setAllowlist(ctx, id, terms.Restriction); err != nil {
+        return 0, err
+    }
+    if err := k.recordPrice(ctx, listing); err != nil {
+        return 0, err
+    }
+    if terms.ExpiresAt != 0 {
+        if err := k.ListingsByExpiry.Set(ctx, collections.Join(terms.ExpiresAt, id)); err != nil {
+            return 0, err
+        }
+    }
+    if nft != nil {
+        if err := k.ListingsByNFTClass.Set(ctx, collections.Join(nft.ClassId, id)); err != nil {
+            return 0, err
+        }
+    }
+    if err := k.recordCreators(ctx, sellerStr, terms.Asset, nft); err != nil {
+        return 0, err
+    }
 
     // emit typed and legacy events
-    sdkCtx := sdk.UnwrapSDKContext(ctx)
     _ = sdkCtx.EventManager().EmitTypedEvent(&types.EventItemListed{
         Id:        id,
         Seller:    sellerStr,
-        Asset:     asset,
-        Price:     price,
+        Asset:     terms.Asset,
+        Price:     terms.Price,
         CreatedAt: t,
+        Nft:       nft,
+        Category:  terms.Category,
+        Tags:      terms.Tags,
     })
-    sdkCtx.EventManager().EmitEvent(
-        sdk.NewEvent(
-            types.EventTypeItemListed,
-            sdk.NewAttribute(types.AttributeKeyListingID, fmt.Sprintf("%d", id)),
-            sdk.NewAttribute(types.AttributeKeySeller, sellerStr),
-            sdk.NewAttribute(types.AttributeKeyAsset, asset.String()),
-            sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
-        ),
-    )
+    attrs := []sdk.Attribute{
+        sdk.NewAttribute(types.AttributeKeyListingID, fmt.Sprintf("%d", id)),
+        sdk.NewAttribute(types.AttributeKeySeller, sellerStr),
+        sdk.NewAttribute(types.AttributeKeyAsset, terms.Asset.String()),
+        sdk.NewAttribute(types.AttributeKeyPrice, terms.Price.String()),
+    }
+    if nft != nil {
+        attrs = append(attrs,
+            sdk.NewAttribute(types.AttributeKeyNFTClassID, nft.ClassId),
+            sdk.NewAttribute(types.AttributeKeyNFTID, nft.NftId),
+        )
+    }
+    sdkCtx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeItemListed, attrs...))
     return id, nil
 }
 
-// BuyItem transfers payment (with commission), releases asset to buyer, and marks the listing as sold.
-func (k Keeper) BuyItem(ctx context.Context, buyer sdk.AccAddress, id uint64) error {
-    listing, err := k.Listings.Get(ctx, id)
+// BuyItem transfers payment in the price option of opts.Denom (with commission), releases
+// the bought quantity of the asset to buyer, and marks the listing as sold once nothing
+// remains. Listings without partial fills must be bought in full.
+func (k Keeper) BuyItem(ctx context.Context, buyer sdk.AccAddress, id uint64, opts types.BuyOptions) error {
+    referrerStr, err := k.referrerString(buyer, opts.Referrer)
     if err != nil {
         return err
     }
-    if listing.Status != types.ListingStatus_LISTING_STATUS_ACTIVE {
-        return types.ErrListingNotActive
+    listing, price, filled, err := k.prepareBuy(ctx, buyer, id, opts)
+    if err != nil {
+        return err
+    }
+    if opts.MaxTotal != nil && (price.Denom != opts.MaxTotal.Denom || price.Amount.GT(opts.MaxTotal.Amount)) {
+        return errorsmod.Wrapf(types.ErrMaxTotalExceeded, "price %s, maximum %s", price, opts.MaxTotal)
+    }
+    return k.completeSale(ctx, listing, buyer, buyer, price, filled, referrerStr)
+}
+
+// prepareBuy runs the checks of BuyItem without moving any funds and returns the listing,
+// the price the buyer would pay and the assets it buys. The commission guard is checked here
+// so that batch purchases are protected against commission changes too.
+func (k Keeper) prepareBuy(ctx context.Context, buyer sdk.AccAddress, id uint64, opts types.BuyOptions) (types.Listing, sdk.Coin, sdk.Coins, error) {
+    listing, err := k.buyableListing(ctx, buyer, id)
+    if err != nil {
+        return types.Listing{}, sdk.Coin{}, nil, err
+    }
+    if opts.ExpectedRevision != nil && *opts.ExpectedRevision != listing.Revision {
+        return types.Listing{}, sdk.Coin{}, nil, errorsmod.Wrapf(types.ErrRevisionMismatch, "listing is at revision %d", listing.Revision)
+    }
+    if err := k.checkEligible(ctx, listing, buyer, opts.Proof); err != nil {
+        return types.Listing{}, sdk.Coin{}, nil, err
+    }
+
+    price, filled, err := k.salePrice(ctx, listing, opts.Quantity, opts.Denom)
+    if err != nil {
+        return types.Listing{}, sdk.Coin{}, nil, err
+    }
+    if opts.MaxFee != nil {
+        fee, _, err := k.commission(ctx, price)
+        if err != nil {
+            return types.Listing{}, sdk.Coin{}, nil, err
+        }
+        if fee.Denom != opts.MaxFee.Denom || fee.Amount.GT(opts.MaxFee.Amount) {
+            return types.Listing{}, sdk.Coin{}, nil, errorsmod.Wrapf(types.ErrMaxFeeExceeded, "commission %s, maximum %s", fee, opts.MaxFee)
+        }
+    }
+    return listing, price, filled, nil
+}
+
+// buyableListing returns listing id if it is open, unexpired and not the buyer's own.
+func (k Keeper) buyableListing(ctx context.Context, buyer sdk.AccAddress, id uint64) (types.Listing, error) {
+    listing, err := k.Listings.Get(ctx, id)
+    if err != nil {
+        return types.Listing{}, err
+    }
+    if !listing.IsOpen() {
+        return types.Listing{}, types.ErrListingNotActive
+    }
+    if k.isExpired(ctx, listing) {
+        return types.Listing{}, types.ErrListingExpired
     }
 
     // prevent self-purchase
     buyerStr, _ := k.addressCodec.BytesToString(buyer)
     if buyerStr == listing.Seller {
-        return types.ErrSelfPurchase
+        return types.Listing{}, types.ErrSelfPurchase
     }
+    return listing, nil
+}
 
-    // transfer price from buyer to seller and commission
-    price := listing.Price
-    sellerAddrBz, err := k.addressCodec.StringToBytes(listing.Seller)
+// QuoteBuy returns what BuyItem would charge buyer for quantity of listing id in denom in
+// the current block, and how the payment would be split between commission, royalties and
+// the seller. Eligibility for restricted listings is not checked.
+func (k Keeper) QuoteBuy(ctx context.Context, buyer sdk.AccAddress, id uint64, quantity sdkmath.Int, denom string) (types.BuyQuote, error) {
+    listing, err := k.buyableListing(ctx, buyer, id)
     if err != nil {
-        return err
+        return types.BuyQuote{}, err
+    }
+    price, filled, err := k.salePrice(ctx, listing, quantity, denom)
+    if err != nil {
+        return types.BuyQuote{}, err
+    }
+    fee, sellerAmount, err := k.commission(ctx, price)
+    if err != nil {
+        return types.BuyQuote{}, err
+    }
+    royalties, err := k.saleRoyalties(ctx, price, filled, listing.Nft)
+    if err != nil {
+        return types.BuyQuote{}, err
+    }
+    for _, r := range royalties {
+        sellerAmount = sellerAmount.Sub(r.Amount)
     }
-    seller := sdk.AccAddress(sellerAddrBz)
+    return types.BuyQuote{
+        ListingId:    listing.Id,
+        Revision:     listing.Revision,
+        Asset:        filled,
+        Total:        price,
+        Fee:          fee,
+        SellerAmount: sellerAmount,
+        Royalties:    royalties,
+    }, nil
+}
 
-    // compute commission
-    params, err := k.Params.Get(ctx)
+// salePrice returns what buying quantity of an open listing costs in denom at the current
+// block, and the assets that buys. A nil or zero quantity buys everything remaining.
+func (k Keeper) salePrice(ctx context.Context, listing types.Listing, quantity sdkmath.Int, denom string) (sdk.Coin, sdk.Coins, error) {
+    price, err := types.PriceIn(k.CurrentPrice(ctx, listing), denom)
     if err != nil {
-        return err
+        return sdk.Coin{}, nil, err
+    }
+
+    filled := listing.RemainingAssets
+    if !quantity.IsNil() && !quantity.IsZero() {
+        switch {
+        case listing.PartialFills:
+            remaining := filled[0].Amount
+            if quantity.IsNegative() || quantity.GT(remaining) {
+                return sdk.Coin{}, nil, errorsmod.Wrapf(types.ErrInvalidQuantity, "%s remaining", remaining)
+            }
+            filled = sdk.NewCoins(sdk.NewCoin(filled[0].Denom, quantity))
+        case len(filled) != 1 || !quantity.Equal(filled[0].Amount):
+            return sdk.Coin{}, nil, errorsmod.Wrap(types.ErrInvalidQuantity, "listing must be bought in full")
+        }
     }
-    rate := params.CommissionRate
-    priceDec := sdkmath.LegacyNewDecFromInt(price.Amount)
-    feeAmt := priceDec.Mul(rate).TruncateInt()
-    sellerAmt := price.Amount.Sub(feeAmt)
-    if sellerAmt.IsNegative() {
-        sellerAmt = sdkmath.ZeroInt()
+    if listing.PartialFills {
+        price = sdk.NewCoin(price.Denom, price.Amount.Mul(filled[0].Amount))
     }
+    return price, filled, nil
+}
+
+// completeSale fills part or all of an open listing's remaining asset to buyer at price:
+// payer covers the seller's share and commission, and the filled coins are released from
+// escrow to buyer. For delivery escrow listings the payment is only moved to escrow and
+// the listing awaits delivery instead of being marked as sold.
+// Once nothing remains the listing is marked as sold and any other open offers on it are
+// refunded. The listing's referrer and an optional buyerReferrer earn referral shares of
+// the commission. Sales are added to the market stats once their payment settles.
+func (k Keeper) completeSale(ctx context.Context, listing types.Listing, payer, buyer sdk.AccAddress, price sdk.Coin, filled sdk.Coins, buyerReferrer string) error {
+    id := listing.Id
+    buyerStr, _ := k.addressCodec.BytesToString(buyer)
 
-    feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+    escrow := authtypes.NewModuleAddress(types.EscrowModuleName)
+    feeCoin := sdk.NewCoin(price.Denom, sdkmath.ZeroInt())
+    sellerCoin := feeCoin
+    var royalties []types.RoyaltyPayment
+    var referrals []types.ReferralPayment
+    if listing.DeliveryEscrow {
+        // referrals are paid with the payment on release
+        listing.BuyerReferrer = buyerReferrer
+        // hold the payment until delivery is confirmed
+        if !payer.Equals(escrow) {
+            if err := k.bankKeeper.SendCoins(ctx, payer, escrow, sdk.NewCoins(price)); err != nil {
+                return err
+            }
+        }
+    } else {
+        // transfer price from payer to seller and commission
+        sellerAddrBz, err := k.addressCodec.StringToBytes(listing.Seller)
+        if err != nil {
+            return err
+        }
 
-    if feeAmt.IsPositive() {
-        if err := k.bankKeeper.SendCoins(ctx, buyer, feeCollector, sdk.NewCoins(sdk.NewCoin(price.Denom, feeAmt))); err != nil {
+        royalties, err = k.saleRoyalties(ctx, price, filled, listing.Nft)
+        if err != nil {
             return err
         }
-    }
-    if sellerAmt.IsPositive() {
-        if err := k.bankKeeper.SendCoins(ctx, buyer, seller, sdk.NewCoins(sdk.NewCoin(price.Denom, sellerAmt))); err != nil {
+        feeCoin, sellerCoin, referrals, err = k.settlePayment(ctx, payer, sdk.AccAddress(sellerAddrBz), price, royalties, []string{listing.Referrer, buyerReferrer})
+        if err != nil {
+            return err
+        }
+        if err := k.recordSale(ctx, price, feeCoin, filled, listing.Nft); err != nil {
             return err
         }
     }
 
-    // release asset from escrow to buyer
-    escrow := authtypes.NewModuleAddress(types.EscrowModuleName)
-    if err := k.bankKeeper.SendCoins(ctx, escrow, buyer, sdk.NewCoins(listing.Asset)); err != nil {
+    // release filled asset from escrow to buyer; an NFT is always sold whole
+    if err := k.releaseEscrow(ctx, buyer, filled, listing.Nft); err != nil {
         return err
     }
 
-    // mark as sold
-    listing.Status = types.ListingStatus_LISTING_STATUS_SOLD
+    listing.RemainingAssets = listing.RemainingAssets.Sub(filled...)
     listing.Buyer = buyerStr
-    if err := k.Listings.Set(ctx, id, listing); err != nil {
+    if err := k.ListingsByBuyer.Set(ctx, collections.Join(buyerStr, id)); err != nil {
         return err
     }
+    if !listing.RemainingAssets.IsZero() {
+        listing.Status = types.ListingStatus_LISTING_STATUS_PARTIALLY_FILLED
+        if err := k.Listings.Set(ctx, id, listing); err != nil {
+            return err
+        }
+    } else {
+        // mark as sold
+        listing.Status = types.ListingStatus_LISTING_STATUS_SOLD
+        if listing.DeliveryEscrow {
+            params, err := k.Params.Get(ctx)
+            if err != nil {
+                return err
+            }
+            // the commission is fixed at the sale, which the buyer's MaxFee was checked against
+            fee, _, err := k.commission(ctx, price)
+            if err != nil {
+                return err
+            }
+            listing.Status = types.ListingStatus_LISTING_STATUS_AWAITING_DELIVERY
+            listing.EscrowedPayment = price
+            listing.EscrowedFee = fee
+            listing.DeliveryDeadline = sdk.UnwrapSDKContext(ctx).BlockTime().Unix() + int64(params.DeliveryTimeout)
+            if err := k.ListingsByDeliveryDeadline.Set(ctx, collections.Join(listing.DeliveryDeadline, id)); err != nil {
+                return err
+            }
+        }
+        if err := k.closeListing(ctx, listing); err != nil {
+            return err
+        }
+        if err := k.refundOpenOffers(ctx, id); err != nil {
+            return err
+        }
+    }
+
+    // quantity is only meaningful for single-coin listings
+    quantity := sdkmath.ZeroInt()
+    if len(filled) == 1 {
+        quantity = filled[0].Amount
+    }
 
     // emit events
     sdkCtx := sdk.UnwrapSDKContext(ctx)
-    feeCoin := sdk.NewCoin(price.Denom, feeAmt)
-    sellerCoin := sdk.NewCoin(price.Denom, sellerAmt)
     _ = sdkCtx.EventManager().EmitTypedEvent(&types.EventItemBought{
         Id:           id,
         Seller:       listing.Seller,
         Buyer:        buyerStr,
-        Asset:        listing.Asset,
+        Asset:        filled,
         Price:        price,
         Fee:          feeCoin,
         SellerAmount: sellerCoin,
+        Quantity:     quantity,
+        Nft:          listing.Nft,
+        Royalties:    royalties,
+        Referrals:    referrals,
     })
-    sdkCtx.EventManager().EmitEvent(
-        sdk.NewEvent(
-            types.EventTypeItemBought,
-            sdk.NewAttribute(types.AttributeKeyListingID, fmt.Sprintf("%d", id)),
-            sdk.NewAttribute(types.AttributeKeySeller, listing.Seller),
-            sdk.NewAttribute(types.AttributeKeyBuyer, buyerStr),
-            sdk.NewAttribute(types.AttributeKeyAsset, listing.Asset.String()),
-            sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
-            sdk.NewAttribute(types.AttributeKeyFee, feeCoin.String()),
-        ),
-    )
+    attrs := []sdk.Attribute{
+        sdk.NewAttribute(types.AttributeKeyListingID, fmt.Sprintf("%d", id)),
+        sdk.NewAttribute(types.AttributeKeySeller, listing.Seller),
+        sdk.NewAttribute(types.AttributeKeyBuyer, buyerStr),
+        sdk.NewAttribute(types.AttributeKeyAsset, filled.String()),
+        sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+        sdk.NewAttribute(types.AttributeKeyFee, feeCoin.String()),
+        sdk.NewAttribute(types.AttributeKeyRoyalty, royaltyTotal(royalties).String()),
+        sdk.NewAttribute(types.AttributeKeyQuantity, quantity.String()),
+    }
+    attrs = append(attrs, referralAttributes(referrals)...)
+    sdkCtx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeItemBought, attrs...))
     return nil
 }
 
+// settlePayment splits price into the module commission, royalties and the seller's
+// share, and sends them from payer. The payer is the buyer for direct sales and the escrow
+// account when the payment was locked beforehand (e.g. a winning auction bid). Royalties
+// come from saleRoyalties, so they never exceed the seller's share. Each of referrers is
+// paid its share out of the commission, and only the rest of the commission is routed.
+func (k Keeper) settlePayment(ctx context.Context, payer, seller sdk.AccAddress, price sdk.Coin, royalties []types.RoyaltyPayment, referrers []string) (fee, sellerAmount sdk.Coin, referrals []types.ReferralPayment, err error) {
+    fee, _, err = k.commission(ctx, price)
+    if err != nil {
+        return fee, sellerAmount, nil, err
+    }
+    return k.settleQuotedPayment(ctx, payer, seller, price, fee, royalties, referrers)
+}
+
+// settleQuotedPayment is settlePayment for a sale whose commission fee was fixed beforehand,
+// with royalties from quotedSaleRoyalties.
+func (k Keeper) settleQuotedPayment(ctx context.Context, payer, seller sdk.AccAddress, price, fee sdk.Coin, royalties []types.RoyaltyPayment, referrers []string) (sdk.Coin, sdk.Coin, []types.ReferralPayment, error) {
+    sellerAmount := price.Sub(fee)
+    if err := k.payRoyalties(ctx, payer, royalties); err != nil {
+        return fee, sellerAmount, nil, err
+    }
+    for _, r := range royalties {
+        sellerAmount = sellerAmount.Sub(r.Amount)
+    }
+
+    referrals, err := k.payReferrals(ctx, payer, fee, referrers)
+    if err != nil {
+        return fee, sellerAmount, nil, err
+    }
+    remainingFee := fee
+    for _, r := range referrals {
+        remainingFee = remainingFee.Sub(r.Amount)
+    }
+    if err := k.routeFee(ctx, payer, remainingFee); err != nil {
+        return fee, sellerAmount, nil, err
+    }
+    if sellerAmount.IsPositive() {
+        if err := k.bankKeeper.SendCoins(ctx, payer, seller, sdk.NewCoins(sellerAmount)); err != nil {
+            return fee, sellerAmount, nil, err
+        }
+    }
+
+    return fee, sellerAmount, referrals, nil
+}
+
+// commission splits price into the module commission under the Params commission
+// schedule and the seller's share.
+func (k Keeper) commission(ctx context.Context, price sdk.Coin) (fee, sellerAmount sdk.Coin, err error) {
+    params, err := k.Params.Get(ctx)
+    if err != nil {
+        return fee, sellerAmount, err
+    }
+    feeAmt := params.Commission(price)
+    return sdk.NewCoin(price.Denom, feeAmt), sdk.NewCoin(price.Denom, price.Amount.Sub(feeAmt)), nil
+}
+
 // DelistItem cancels an active listing, only by seller, and returns asset to seller.
 func (k Keeper) DelistItem(ctx context.Context, seller sdk.AccAddress, id uint64) error {
     listing, err := k.Listings.Get(ctx, id)
     if err != nil {
         return err
     }
-    if listing.Status != types.ListingStatus_LISTING_STATUS_ACTIVE {
+    if !listing.IsOpen() {
         return types.ErrListingNotActive
     }
 
@@ -179,14 +480,17 @@ func (k Keeper) DelistItem(ctx context.Context, seller sdk.AccAddress, id uint64
         return types.ErrUnauthorized
     }
 
-    // return asset from escrow to seller
-    escrow := authtypes.NewModuleAddress(types.EscrowModuleName)
-    if err := k.bankKeeper.SendCoins(ctx, escrow, seller, sdk.NewCoins(listing.Asset)); err != nil {
+    // return unsold asset from escrow to seller
+    if err := k.releaseEscrow(ctx, seller, listing.RemainingAssets, listing.Nft); err != nil {
         return err
     }
 
     listing.Status = types.ListingStatus_LISTING_STATUS_CANCELLED
-    if err := k.Listings.Set(ctx, id, listing); err != nil {
+    if err := k.closeListing(ctx, listing); err != nil {
+        return err
+    }
+
+    if err := k.refundOpenOffers(ctx, id); err != nil {
         return err
     }
 
@@ -205,6 +509,260 @@ func (k Keeper) DelistItem(ctx context.Context, seller sdk.AccAddress, id uint64
     return nil
 }
 
+// UpdateListing changes an open listing in place, only by seller, and returns its new
+// revision. Empty title, description, price or asset are left unchanged; a new asset
+// bundle, limited to the listed denoms, tops up or returns the difference of the escrowed
+// asset. Each price change is recorded in the listing's price history.
+func (k Keeper) UpdateListing(ctx context.Context, seller sdk.AccAddress, id uint64, title, description string, price, asset sdk.Coins) (uint64, error) {
+    listing, err := k.Listings.Get(ctx, id)
+    if err != nil {
+        return 0, err
+    }
+    if !listing.IsOpen() {
+        return 0, types.ErrListingNotActive
+    }
+    if k.isExpired(ctx, listing) {
+        return 0, types.ErrListingExpired
+    }
+
+    sellerStr, _ := k.addressCodec.BytesToString(seller)
+    if sellerStr != listing.Seller {
+        return 0, types.ErrUnauthorized
+    }
+
+    newPrice := listing.Price
+    if !price.Empty() {
+        newPrice = price
+    }
+    remaining := listing.RemainingAssets
+    newRemaining := remaining
+    if !asset.Empty() {
+        newRemaining = asset
+    }
+    for _, coin := range newRemaining {
+        if found, _ := listing.Asset.Find(coin.Denom); !found {
+            return 0, errorsmod.Wrapf(types.ErrInvalidQuantity, "asset denoms must be among %s", listing.Asset)
+        }
+    }
+    if err := types.ValidateListingTerms(newRemaining, newPrice, listing.Dutch, listing.PartialFills, listing.Nft); err != nil {
+        return 0, err
+    }
+
+    // top up or release escrow by the difference
+    var topUp, release sdk.Coins
+    delta, _ := newRemaining.SafeSub(remaining...)
+    for _, coin := range delta {
+        if coin.IsNegative() {
+            release = append(release, sdk.NewCoin(coin.Denom, coin.Amount.Neg()))
+        } else {
+            topUp = append(topUp, coin)
+        }
+    }
+    escrow := authtypes.NewModuleAddress(types.EscrowModuleName)
+    if !topUp.Empty() {
+        if err := k.bankKeeper.SendCoins(ctx, seller, escrow, topUp); err != nil {
+            return 0, err
+        }
+    }
+    if !release.Empty() {
+        if err := k.bankKeeper.SendCoins(ctx, escrow, seller, release); err != nil {
+            return 0, err
+        }
+    }
+    // the listed asset keeps counting what was already sold
+    listing.Asset = listing.Asset.Add(topUp...).Sub(release...)
+    listing.RemainingAssets = newRemaining
+
+    if title != "" {
+        listing.Title = title
+    }
+    if description != "" {
+        listing.Description = description
+    }
+    priceChanged := !newPrice.Equal(listing.Price)
+    listing.Price = newPrice
+    listing.Revision++
+
+    if err := k.Listings.Set(ctx, id, listing); err != nil {
+        return 0, err
+    }
+    if priceChanged {
+        if err := k.recordPrice(ctx, listing); err != nil {
+            return 0, err
+        }
+    }
+
+    sdkCtx := sdk.UnwrapSDKContext(ctx)
+    _ = sdkCtx.EventManager().EmitTypedEvent(&types.EventItemUpdated{
+        Id:       id,
+        Seller:   sellerStr,
+        Asset:    newRemaining,
+        Price:    newPrice,
+        Revision: listing.Revision,
+    })
+    sdkCtx.EventManager().EmitEvent(
+        sdk.NewEvent(
+            types.EventTypeItemUpdated,
+            sdk.NewAttribute(types.AttributeKeyListingID, fmt.Sprintf("%d", id)),
+            sdk.NewAttribute(types.AttributeKeySeller, sellerStr),
+            sdk.NewAttribute(types.AttributeKeyAsset, newRemaining.String()),
+            sdk.NewAttribute(types.AttributeKeyPrice, newPrice.String()),
+            sdk.NewAttribute(types.AttributeKeyRevision, fmt.Sprintf("%d", listing.Revision)),
+        ),
+    )
+    return listing.Revision, nil
+}
+
+// releaseEscrow sends escrowed coins and an optional NFT of a listing to recipient.
+func (k Keeper) releaseEscrow(ctx context.Context, recipient sdk.AccAddress, coins sdk.Coins, nft *types.NFTAsset) error {
+    escrow := authtypes.NewModuleAddress(types.EscrowModuleName)
+    if !coins.Empty() {
+        if err := k.bankKeeper.SendCoins(ctx, escrow, recipient, coins); err != nil {
+            return err
+        }
+    }
+    if nft != nil {
+        return k.nftKeeper.Transfer(ctx, nft.ClassId, nft.NftId, recipient)
+    }
+    return nil
+}
+
+// recordPrice appends the listing's current price to its price history.
+func (k Keeper) recordPrice(ctx context.Context, listing types.Listing) error {
+    sdkCtx := sdk.UnwrapSDKContext(ctx)
+    return k.PriceHistory.Set(ctx, collections.Join(listing.Id, listing.Revision), types.PriceChange{
+        Revision:    listing.Revision,
+        Price:       listing.Price,
+        BlockHeight: sdkCtx.BlockHeight(),
+        BlockTime:   sdkCtx.BlockTime().Unix(),
+    })
+}
+
+// setAllowlist stores the eligible buyers named by a reserved buyer or allowlist restriction.
+func (k Keeper) setAllowlist(ctx context.Context, id uint64, restriction *types.ListingRestriction) error {
+    if restriction == nil {
+        return nil
+    }
+    addrs := restriction.Allowlist
+    if restriction.ReservedBuyer != "" {
+        addrs = []string{restriction.ReservedBuyer}
+    }
+    for _, addr := range addrs {
+        if _, err := k.addressCodec.StringToBytes(addr); err != nil {
+            return errorsmod.Wrapf(types.ErrInvalidRestriction, "invalid address %s: %s", addr, err)
+        }
+        if err := k.ListingAllowlist.Set(ctx, collections.Join(id, addr)); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+// checkEligible returns ErrBuyerNotEligible unless buyer may buy a restricted listing.
+func (k Keeper) checkEligible(ctx context.Context, listing types.Listing, buyer sdk.AccAddress, proof [][]byte) error {
+    switch listing.Access {
+    case types.ListingAccess_LISTING_ACCESS_PUBLIC:
+        return nil
+    case types.ListingAccess_LISTING_ACCESS_MERKLE:
+        if types.VerifyMerkleProof(listing.MerkleRoot, types.MerkleLeaf(buyer), proof) {
+            return nil
+        }
+    default:
+        buyerStr, _ := k.addressCodec.BytesToString(buyer)
+        ok, err := k.ListingAllowlist.Has(ctx, collections.Join(listing.Id, buyerStr))
+        if err != nil {
+            return err
+        }
+        if ok {
+            return nil
+        }
+    }
+    return types.ErrBuyerNotEligible
+}
+
+// closeListing stores a listing that left the active state, settles its deposit and drops
+// it from the expiry index and allowlist.
+func (k Keeper) closeListing(ctx context.Context, listing types.Listing) error {
+    if err := k.Listings.Set(ctx, listing.Id, listing); err != nil {
+        return err
+    }
+    if err := k.settleListingDeposit(ctx, listing); err != nil {
+        return err
+    }
+    if err := k.ListingAllowlist.Clear(ctx, collections.NewPrefixedPairRange[uint64, string](listing.Id)); err != nil {
+        return err
+    }
+    if listing.ExpiresAt != 0 {
+        return k.ListingsByExpiry.Remove(ctx, collections.Join(listing.ExpiresAt, listing.Id))
+    }
+    return nil
+}
+
+// isExpired reports whether a listing's expiry has been reached, even if EndBlock has not
+// processed it yet.
+func (k Keeper) isExpired(ctx context.Context, listing types.Listing) bool {
+    return listing.ExpiresAt != 0 && listing.ExpiresAt <= sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+}
+
+// ExpireListings returns the assets of listings whose expiry has been reached to their
+// sellers, oldest first and at most Params.MaxExpiredListingsPerBlock per block. A listing
+// that fails to expire is logged and dropped from the queue; it can no longer be bought and
+// its seller can still delist it.
+func (k Keeper) ExpireListings(ctx context.Context) error {
+    params, err := k.Params.Get(ctx)
+    if err != nil {
+        return err
+    }
+    return k.processDue(ctx, k.ListingsByExpiry, int(params.MaxExpiredListingsPerBlock), "expire listing", k.expireListing)
+}
+
+// expireListing returns the asset still for sale on an expired listing to its seller.
+func (k Keeper) expireListing(ctx context.Context, id uint64) error {
+    listing, err := k.Listings.Get(ctx, id)
+    if err != nil {
+        return err
+    }
+
+    sellerBz, err := k.addressCodec.StringToBytes(listing.Seller)
+    if err != nil {
+        return err
+    }
+    if err := k.releaseEscrow(ctx, sdk.AccAddress(sellerBz), listing.RemainingAssets, listing.Nft); err != nil {
+        return err
+    }
+
+    listing.Status = types.ListingStatus_LISTING_STATUS_EXPIRED
+    if err := k.closeListing(ctx, listing); err != nil {
+        return err
+    }
+    if err := k.refundOpenOffers(ctx, listing.Id); err != nil {
+        return err
+    }
+
+    sdkCtx := sdk.UnwrapSDKContext(ctx)
+    _ = sdkCtx.EventManager().EmitTypedEvent(&types.EventItemExpired{
+        Id:        listing.Id,
+        Seller:    listing.Seller,
+        Asset:     listing.RemainingAssets,
+        ExpiresAt: listing.ExpiresAt,
+    })
+    sdkCtx.EventManager().EmitEvent(
+        sdk.NewEvent(
+            types.EventTypeItemExpired,
+            sdk.NewAttribute(types.AttributeKeyListingID, fmt.Sprintf("%d", listing.Id)),
+            sdk.NewAttribute(types.AttributeKeySeller, listing.Seller),
+            sdk.NewAttribute(types.AttributeKeyAsset, listing.RemainingAssets.String()),
+        ),
+    )
+    return nil
+}
+
+// CurrentPrice returns the price options a listing sells for in the current block.
+func (k Keeper) CurrentPrice(ctx context.Context, listing types.Listing) sdk.Coins {
+    sdkCtx := sdk.UnwrapSDKContext(ctx)
+    return listing.PriceAt(sdkCtx.BlockHeight(), sdkCtx.BlockTime().Unix())
+}
+
 // GetListing returns a listing by ID and a boolean whether it exists.
 func (k Keeper) GetListing(ctx context.Context, id uint64) (types.Listing, bool) {
     listing, err := k.Listings.Get(ctx, id)
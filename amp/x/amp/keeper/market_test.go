@@ -0,0 +1,554 @@
+package keeper_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"amp/x/amp/keeper"
+	"amp/x/amp/types"
+)
+
+func TestListingExpiry(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	params := types.DefaultParams()
+	params.MaxExpiredListingsPerBlock = 1
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	seller := sdk.AccAddress("seller______________")
+	buyer := sdk.AccAddress("buyer_______________")
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 30)))
+	f.bankKeeper.fund(buyer, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+
+	_, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), ExpiresAt: 1000})
+	require.ErrorIs(t, err, types.ErrInvalidExpiry)
+
+	first, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), ExpiresAt: 1100})
+	require.NoError(t, err)
+	second, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), ExpiresAt: 1100})
+	require.NoError(t, err)
+	sold, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), ExpiresAt: 1100})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.BuyItem(ctx, buyer, sold, types.BuyOptions{}))
+
+	ctx = ctx.WithBlockTime(time.Unix(1100, 0))
+	require.ErrorIs(t, f.keeper.BuyItem(ctx, buyer, first, types.BuyOptions{}), types.ErrListingExpired)
+
+	// only one listing is processed per block
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	listing, _ := f.keeper.GetListing(ctx, first)
+	require.Equal(t, types.ListingStatus_LISTING_STATUS_EXPIRED, listing.Status)
+	listing, _ = f.keeper.GetListing(ctx, second)
+	require.Equal(t, types.ListingStatus_LISTING_STATUS_ACTIVE, listing.Status)
+	require.Equal(t, int64(10), f.bankKeeper.balance(seller, "token").Int64())
+
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	listing, _ = f.keeper.GetListing(ctx, second)
+	require.Equal(t, types.ListingStatus_LISTING_STATUS_EXPIRED, listing.Status)
+	listing, _ = f.keeper.GetListing(ctx, sold)
+	require.Equal(t, types.ListingStatus_LISTING_STATUS_SOLD, listing.Status)
+	require.Equal(t, int64(20), f.bankKeeper.balance(seller, "token").Int64())
+}
+
+func TestExpirySkipsFailingListingsAndOffers(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	params := types.DefaultParams()
+	params.MaxExpiredListingsPerBlock = 1
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	seller := sdk.AccAddress("seller______________")
+	blocked := sdk.AccAddress("blocked_____________")
+	buyer := sdk.AccAddress("buyer_______________")
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 10)))
+	f.bankKeeper.fund(blocked, sdk.NewCoins(sdk.NewInt64Coin("token", 10), sdk.NewInt64Coin("stake", 10)))
+	f.bankKeeper.fund(buyer, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+
+	failing, err := f.keeper.ListItem(ctx, blocked, types.ListingTerms{Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), ExpiresAt: 1100})
+	require.NoError(t, err)
+	expiring, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), ExpiresAt: 1300})
+	require.NoError(t, err)
+	failingOffer, err := f.keeper.MakeOffer(ctx, blocked, expiring, sdk.NewInt64Coin("stake", 10), 1100)
+	require.NoError(t, err)
+	expiringOffer, err := f.keeper.MakeOffer(ctx, buyer, expiring, sdk.NewInt64Coin("stake", 10), 1100)
+	require.NoError(t, err)
+
+	listingStatus := func(id uint64) types.ListingStatus {
+		listing, found := f.keeper.GetListing(ctx, id)
+		require.True(t, found)
+		return listing.Status
+	}
+	offerStatus := func(id uint64) types.OfferStatus {
+		offer, found := f.keeper.GetOffer(ctx, id)
+		require.True(t, found)
+		return offer.Status
+	}
+
+	// the blocked account can receive neither its asset nor its refund; each failure is
+	// skipped without halting the block and leaves its queue
+	f.bankKeeper.block(blocked)
+	ctx = ctx.WithBlockTime(time.Unix(1200, 0))
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	require.Equal(t, types.ListingStatus_LISTING_STATUS_ACTIVE, listingStatus(failing))
+	require.Equal(t, types.OfferStatus_OFFER_STATUS_OPEN, offerStatus(failingOffer))
+
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	require.Equal(t, types.OfferStatus_OFFER_STATUS_EXPIRED, offerStatus(expiringOffer))
+	require.Equal(t, int64(10), f.bankKeeper.balance(buyer, "stake").Int64())
+
+	// the skipped offer stays open until its buyer cancels it or its listing closes
+	delete(f.bankKeeper.blocked, blocked.String())
+	ctx = ctx.WithBlockTime(time.Unix(1300, 0))
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	require.Equal(t, types.ListingStatus_LISTING_STATUS_EXPIRED, listingStatus(expiring))
+	require.Equal(t, types.OfferStatus_OFFER_STATUS_REFUNDED, offerStatus(failingOffer))
+	require.Equal(t, int64(10), f.bankKeeper.balance(seller, "token").Int64())
+	require.Equal(t, int64(10), f.bankKeeper.balance(blocked, "stake").Int64())
+
+	// the skipped listing can no longer be bought, and its seller can still delist it
+	require.Equal(t, types.ListingStatus_LISTING_STATUS_ACTIVE, listingStatus(failing))
+	require.ErrorIs(t, f.keeper.BuyItem(ctx, buyer, failing, types.BuyOptions{}), types.ErrListingExpired)
+	require.NoError(t, f.keeper.DelistItem(ctx, blocked, failing))
+	require.Equal(t, int64(10), f.bankKeeper.balance(blocked, "token").Int64())
+}
+
+func TestPartialFills(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	params := types.DefaultParams()
+	params.CommissionRate = sdkmath.LegacyNewDecWithPrec(1, 1)
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	seller := sdk.AccAddress("seller______________")
+	buyer := sdk.AccAddress("buyer_______________")
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 1000)))
+	f.bankKeeper.fund(buyer, sdk.NewCoins(sdk.NewInt64Coin("stake", 10000)))
+
+	// 1000token at 2stake per unit
+	id, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 1000)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 2)), PartialFills: true})
+	require.NoError(t, err)
+
+	require.ErrorIs(t, f.keeper.BuyItem(ctx, buyer, id, types.BuyOptions{Quantity: sdkmath.NewInt(1001)}), types.ErrInvalidQuantity)
+	require.NoError(t, f.keeper.BuyItem(ctx, buyer, id, types.BuyOptions{Quantity: sdkmath.NewInt(300)}))
+
+	listing, _ := f.keeper.GetListing(ctx, id)
+	require.Equal(t, types.ListingStatus_LISTING_STATUS_PARTIALLY_FILLED, listing.Status)
+	require.Equal(t, int64(700), listing.RemainingAssets.AmountOf("token").Int64())
+	require.Equal(t, int64(300), f.bankKeeper.balance(buyer, "token").Int64())
+	require.Equal(t, int64(540), f.bankKeeper.balance(seller, "stake").Int64())
+	require.Equal(t, int64(60), f.bankKeeper.balance(authtypes.NewModuleAddress(authtypes.FeeCollectorName), "stake").Int64())
+
+	// an empty quantity takes the rest
+	require.NoError(t, f.keeper.BuyItem(ctx, buyer, id, types.BuyOptions{}))
+	listing, _ = f.keeper.GetListing(ctx, id)
+	require.Equal(t, types.ListingStatus_LISTING_STATUS_SOLD, listing.Status)
+	require.True(t, listing.RemainingAssets.IsZero())
+	require.Equal(t, int64(1000), f.bankKeeper.balance(buyer, "token").Int64())
+	require.Equal(t, int64(8000), f.bankKeeper.balance(buyer, "stake").Int64())
+
+	// whole listings cannot be split
+	whole, err := f.keeper.ListItem(ctx, buyer, types.ListingTerms{Title: "t", Description: "d", Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))})
+	require.NoError(t, err)
+	require.ErrorIs(t, f.keeper.BuyItem(ctx, seller, whole, types.BuyOptions{Quantity: sdkmath.NewInt(5)}), types.ErrInvalidQuantity)
+}
+
+func TestBundleWithPriceOptions(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	seller := sdk.AccAddress("seller______________")
+	buyer := sdk.AccAddress("buyer_______________")
+	bundle := sdk.NewCoins(sdk.NewInt64Coin("gold", 1), sdk.NewInt64Coin("silver", 5))
+	f.bankKeeper.fund(seller, bundle)
+	f.bankKeeper.fund(buyer, sdk.NewCoins(sdk.NewInt64Coin("stake", 5000), sdk.NewInt64Coin("token", 50)))
+
+	options := sdk.NewCoins(sdk.NewInt64Coin("token", 100), sdk.NewInt64Coin("stake", 5000))
+	id, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: bundle, Price: options})
+	require.NoError(t, err)
+	require.True(t, f.bankKeeper.balance(seller, "silver").IsZero())
+
+	require.ErrorIs(t, f.keeper.BuyItem(ctx, buyer, id, types.BuyOptions{}), types.ErrInvalidDenom)
+	require.ErrorIs(t, f.keeper.BuyItem(ctx, buyer, id, types.BuyOptions{Denom: "atom"}), types.ErrInvalidDenom)
+	require.ErrorIs(t, f.keeper.BuyItem(ctx, buyer, id, types.BuyOptions{Quantity: sdkmath.NewInt(1), Denom: "stake"}), types.ErrInvalidQuantity)
+	require.Error(t, f.keeper.BuyItem(ctx, buyer, id, types.BuyOptions{Denom: "token"}))
+
+	require.NoError(t, f.keeper.BuyItem(ctx, buyer, id, types.BuyOptions{Denom: "stake"}))
+	require.Equal(t, int64(1), f.bankKeeper.balance(buyer, "gold").Int64())
+	require.Equal(t, int64(5), f.bankKeeper.balance(buyer, "silver").Int64())
+	require.Equal(t, int64(5000), f.bankKeeper.balance(seller, "stake").Int64())
+	require.True(t, f.bankKeeper.balance(seller, "token").IsZero())
+
+	listing, _ := f.keeper.GetListing(ctx, id)
+	require.Equal(t, types.ListingStatus_LISTING_STATUS_SOLD, listing.Status)
+	require.Equal(t, bundle, listing.Asset)
+}
+
+func TestUpdateListing(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0)).WithBlockHeight(10)
+
+	seller := sdk.AccAddress("seller______________")
+	other := sdk.AccAddress("other_______________")
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 100)))
+
+	id, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 50)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))})
+	require.NoError(t, err)
+
+	newPrice := sdk.NewCoins(sdk.NewInt64Coin("stake", 20))
+	_, err = f.keeper.UpdateListing(ctx, other, id, "", "", newPrice, nil)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	wrongDenom := sdk.NewCoins(sdk.NewInt64Coin("other", 60))
+	_, err = f.keeper.UpdateListing(ctx, seller, id, "", "", nil, wrongDenom)
+	require.ErrorIs(t, err, types.ErrInvalidQuantity)
+
+	// top up the escrow and change the price
+	topUp := sdk.NewCoins(sdk.NewInt64Coin("token", 80))
+	ctx = ctx.WithBlockTime(time.Unix(2000, 0)).WithBlockHeight(20)
+	revision, err := f.keeper.UpdateListing(ctx, seller, id, "new title", "", newPrice, topUp)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), revision)
+	require.Equal(t, int64(20), f.bankKeeper.balance(seller, "token").Int64())
+
+	listing, _ := f.keeper.GetListing(ctx, id)
+	require.Equal(t, "new title", listing.Title)
+	require.Equal(t, "d", listing.Description)
+	require.Equal(t, topUp, listing.Asset)
+	require.Equal(t, newPrice, listing.Price)
+
+	// reduce the escrow without a price change
+	reduce := sdk.NewCoins(sdk.NewInt64Coin("token", 30))
+	revision, err = f.keeper.UpdateListing(ctx, seller, id, "", "", nil, reduce)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), revision)
+	require.Equal(t, int64(70), f.bankKeeper.balance(seller, "token").Int64())
+
+	var history []types.PriceChange
+	require.NoError(t, f.keeper.PriceHistory.Walk(ctx, nil, func(_ collections.Pair[uint64, uint64], change types.PriceChange) (bool, error) {
+		history = append(history, change)
+		return false, nil
+	}))
+	require.Len(t, history, 2)
+	require.Equal(t, int64(10), history[0].Price.AmountOf("stake").Int64())
+	require.Equal(t, int64(1000), history[0].BlockTime)
+	require.Equal(t, int64(20), history[1].Price.AmountOf("stake").Int64())
+	require.Equal(t, int64(2000), history[1].BlockTime)
+	require.Equal(t, uint64(1), history[1].Revision)
+}
+
+func TestBuyQuoteAndGuards(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.CommissionRate = sdkmath.LegacyNewDecWithPrec(1, 1)
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	seller := sdk.AccAddress("seller______________")
+	buyer := sdk.AccAddress("buyer_______________")
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 10)))
+	f.bankKeeper.fund(buyer, sdk.NewCoins(sdk.NewInt64Coin("stake", 500)))
+
+	id, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))})
+	require.NoError(t, err)
+
+	res, err := qs.BuyQuote(ctx, &types.QueryBuyQuoteRequest{Id: id, Buyer: buyer.String()})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), res.Quote.Revision)
+	require.Equal(t, sdk.NewInt64Coin("stake", 100), res.Quote.Total)
+	require.Equal(t, sdk.NewInt64Coin("stake", 10), res.Quote.Fee)
+	require.Equal(t, sdk.NewInt64Coin("stake", 90), res.Quote.SellerAmount)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("token", 10)), res.Quote.Asset)
+	_, err = qs.BuyQuote(ctx, &types.QueryBuyQuoteRequest{Id: id, Buyer: seller.String()})
+	require.Error(t, err)
+
+	// the seller raises the price after the buyer looked
+	_, err = f.keeper.UpdateListing(ctx, seller, id, "", "", sdk.NewCoins(sdk.NewInt64Coin("stake", 120)), nil)
+	require.NoError(t, err)
+
+	seen := res.Quote.Revision
+	require.ErrorIs(t, f.keeper.BuyItem(ctx, buyer, id, types.BuyOptions{ExpectedRevision: &seen}), types.ErrRevisionMismatch)
+	require.ErrorIs(t, f.keeper.BuyItem(ctx, buyer, id, types.BuyOptions{MaxTotal: &res.Quote.Total}), types.ErrMaxTotalExceeded)
+	other := sdk.NewInt64Coin("token", 1000)
+	require.ErrorIs(t, f.keeper.BuyItem(ctx, buyer, id, types.BuyOptions{MaxTotal: &other}), types.ErrMaxTotalExceeded)
+	require.Equal(t, int64(500), f.bankKeeper.balance(buyer, "stake").Int64())
+
+	seen++
+	maxTotal := sdk.NewInt64Coin("stake", 120)
+	require.NoError(t, f.keeper.BuyItem(ctx, buyer, id, types.BuyOptions{MaxTotal: &maxTotal, ExpectedRevision: &seen}))
+	require.Equal(t, int64(380), f.bankKeeper.balance(buyer, "stake").Int64())
+}
+
+func TestBuyMaxFeeGuard(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	params := types.DefaultParams()
+	params.CommissionRate = sdkmath.LegacyNewDecWithPrec(1, 1)
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	seller := sdk.AccAddress("seller______________")
+	buyer := sdk.AccAddress("buyer_______________")
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 10)))
+	f.bankKeeper.fund(buyer, sdk.NewCoins(sdk.NewInt64Coin("stake", 500)))
+
+	id, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))})
+	require.NoError(t, err)
+
+	quote, err := f.keeper.QuoteBuy(ctx, buyer, id, sdkmath.Int{}, "")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("stake", 10), quote.Fee)
+
+	// governance raises the commission between the quote and the purchase
+	params.CommissionRate = sdkmath.LegacyNewDecWithPrec(2, 1)
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	require.ErrorIs(t, f.keeper.BuyItem(ctx, buyer, id, types.BuyOptions{MaxTotal: &quote.Total, MaxFee: &quote.Fee}), types.ErrMaxFeeExceeded)
+	other := sdk.NewInt64Coin("token", 1000)
+	require.ErrorIs(t, f.keeper.BuyItem(ctx, buyer, id, types.BuyOptions{MaxFee: &other}), types.ErrMaxFeeExceeded)
+	_, err = f.keeper.BatchBuy(ctx, buyer, []types.BatchBuyItem{{ListingId: id, MaxFee: &quote.Fee}}, sdk.NewCoins(quote.Total))
+	require.ErrorIs(t, err, types.ErrMaxFeeExceeded)
+	require.Equal(t, int64(500), f.bankKeeper.balance(buyer, "stake").Int64())
+
+	maxFee := sdk.NewInt64Coin("stake", 20)
+	require.NoError(t, f.keeper.BuyItem(ctx, buyer, id, types.BuyOptions{MaxTotal: &quote.Total, MaxFee: &maxFee}))
+	require.Equal(t, int64(400), f.bankKeeper.balance(buyer, "stake").Int64())
+	require.Equal(t, int64(80), f.bankKeeper.balance(seller, "stake").Int64())
+}
+
+func TestRestrictedListings(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	seller := sdk.AccAddress("seller______________")
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	carol := sdk.AccAddress("carol_______________")
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 30)))
+	for _, addr := range []sdk.AccAddress{alice, bob, carol} {
+		f.bankKeeper.fund(addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	}
+	list := func(r *types.ListingRestriction) (uint64, error) {
+		return f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), Restriction: r})
+	}
+
+	_, err := list(&types.ListingRestriction{ReservedBuyer: alice.String(), Allowlist: []string{bob.String()}})
+	require.ErrorIs(t, err, types.ErrInvalidRestriction)
+	_, err = list(&types.ListingRestriction{MerkleRoot: []byte("short")})
+	require.ErrorIs(t, err, types.ErrInvalidRestriction)
+
+	reserved, err := list(&types.ListingRestriction{ReservedBuyer: alice.String()})
+	require.NoError(t, err)
+	require.ErrorIs(t, f.keeper.BuyItem(ctx, bob, reserved, types.BuyOptions{}), types.ErrBuyerNotEligible)
+	require.NoError(t, f.keeper.BuyItem(ctx, alice, reserved, types.BuyOptions{}))
+
+	allowlisted, err := list(&types.ListingRestriction{Allowlist: []string{alice.String(), bob.String()}})
+	require.NoError(t, err)
+	listing, _ := f.keeper.GetListing(ctx, allowlisted)
+	require.Equal(t, types.ListingAccess_LISTING_ACCESS_ALLOWLIST, listing.Access)
+	require.ErrorIs(t, f.keeper.BuyItem(ctx, carol, allowlisted, types.BuyOptions{}), types.ErrBuyerNotEligible)
+	require.NoError(t, f.keeper.BuyItem(ctx, bob, allowlisted, types.BuyOptions{}))
+
+	// the allowlist is dropped once the listing closes
+	has, err := f.keeper.ListingAllowlist.Has(ctx, collections.Join(allowlisted, alice.String()))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// two-leaf tree over alice and bob
+	aliceLeaf, bobLeaf := types.MerkleLeaf(alice), types.MerkleLeaf(bob)
+	pair := append(append([]byte{}, aliceLeaf...), bobLeaf...)
+	if bytes.Compare(aliceLeaf, bobLeaf) > 0 {
+		pair = append(append([]byte{}, bobLeaf...), aliceLeaf...)
+	}
+	root := sha256.Sum256(pair)
+
+	merkle, err := list(&types.ListingRestriction{MerkleRoot: root[:]})
+	require.NoError(t, err)
+	require.ErrorIs(t, f.keeper.BuyItem(ctx, carol, merkle, types.BuyOptions{Proof: [][]byte{aliceLeaf}}), types.ErrBuyerNotEligible)
+	require.ErrorIs(t, f.keeper.BuyItem(ctx, alice, merkle, types.BuyOptions{}), types.ErrBuyerNotEligible)
+	require.NoError(t, f.keeper.BuyItem(ctx, alice, merkle, types.BuyOptions{Proof: [][]byte{bobLeaf}}))
+}
+
+func TestNFTListing(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	seller := sdk.AccAddress("seller______________")
+	buyer := sdk.AccAddress("buyer_______________")
+	escrow := authtypes.NewModuleAddress(types.EscrowModuleName)
+	f.bankKeeper.fund(buyer, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	f.nftKeeper.mint("art", "1", seller)
+	f.nftKeeper.mint("art", "2", seller)
+	f.nftKeeper.mint("music", "1", seller)
+
+	price := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	list := func(from sdk.AccAddress, nft *types.NFTAsset) (uint64, error) {
+		return f.keeper.ListItem(ctx, from, types.ListingTerms{Title: "t", Description: "d", Asset: sdk.Coins{}, Price: price, NFT: nft})
+	}
+
+	_, err := list(buyer, &types.NFTAsset{ClassId: "art", NftId: "1"})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	sold, err := list(seller, &types.NFTAsset{ClassId: "art", NftId: "1"})
+	require.NoError(t, err)
+	delisted, err := list(seller, &types.NFTAsset{ClassId: "art", NftId: "2"})
+	require.NoError(t, err)
+	_, err = list(seller, &types.NFTAsset{ClassId: "music", NftId: "1"})
+	require.NoError(t, err)
+	require.Equal(t, escrow, f.nftKeeper.GetOwner(ctx, "art", "1"))
+
+	require.NoError(t, f.keeper.BuyItem(ctx, buyer, sold, types.BuyOptions{}))
+	require.Equal(t, buyer, f.nftKeeper.GetOwner(ctx, "art", "1"))
+	require.Equal(t, int64(100), f.bankKeeper.balance(seller, "stake").Int64())
+	listing, _ := f.keeper.GetListing(ctx, sold)
+	require.Equal(t, types.ListingStatus_LISTING_STATUS_SOLD, listing.Status)
+
+	require.NoError(t, f.keeper.DelistItem(ctx, seller, delisted))
+	require.Equal(t, seller, f.nftKeeper.GetOwner(ctx, "art", "2"))
+
+	res, err := keeper.NewQueryServerImpl(f.keeper).ListingsByNFTClass(ctx, &types.QueryListingsByNFTClassRequest{ClassId: "art"})
+	require.NoError(t, err)
+	require.Len(t, res.Listings, 2)
+	require.Equal(t, sold, res.Listings[0].Id)
+	require.Equal(t, delisted, res.Listings[1].Id)
+}
+
+func TestListingsPagination(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	seller := sdk.AccAddress("seller______________")
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 50)))
+	for i := 0; i < 5; i++ {
+		_, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))})
+		require.NoError(t, err)
+	}
+	ids := func(listings []*types.Listing) []uint64 {
+		out := make([]uint64, 0, len(listings))
+		for _, l := range listings {
+			out = append(out, l.Id)
+		}
+		return out
+	}
+
+	res, err := qs.Listings(ctx, &types.QueryListingsRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 1}, ids(res.Listings))
+	require.Equal(t, uint64(5), res.Pagination.Total)
+
+	res, err = qs.Listings(ctx, &types.QueryListingsRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 3}, ids(res.Listings))
+
+	res, err = qs.Listings(ctx, &types.QueryListingsRequest{Pagination: &query.PageRequest{Offset: 4, Limit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{4}, ids(res.Listings))
+	require.Nil(t, res.Pagination.NextKey)
+
+	// newest first
+	res, err = qs.Listings(ctx, &types.QueryListingsRequest{Pagination: &query.PageRequest{Limit: 3, Reverse: true}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{4, 3, 2}, ids(res.Listings))
+
+	_, err = qs.Listings(ctx, &types.QueryListingsRequest{Pagination: &query.PageRequest{Key: []byte{1}, Offset: 1}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = qs.ListingsByCategory(ctx, &types.QueryListingsByCategoryRequest{Category: "art", Pagination: &query.PageRequest{Key: []byte{1}, Offset: 1}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestListingIndexQueries(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	buyer := sdk.AccAddress("buyer_______________")
+	f.bankKeeper.fund(alice, sdk.NewCoins(sdk.NewInt64Coin("token", 40)))
+	f.bankKeeper.fund(bob, sdk.NewCoins(sdk.NewInt64Coin("token", 10)))
+	f.bankKeeper.fund(buyer, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	list := func(seller sdk.AccAddress) uint64 {
+		id, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))})
+		require.NoError(t, err)
+		return id
+	}
+	ids := func(listings []*types.Listing) []uint64 {
+		out := make([]uint64, 0, len(listings))
+		for _, l := range listings {
+			out = append(out, l.Id)
+		}
+		return out
+	}
+
+	a0, b1, a2, a3, a4 := list(alice), list(bob), list(alice), list(alice), list(alice)
+	require.NoError(t, f.keeper.BuyItem(ctx, buyer, a2, types.BuyOptions{}))
+	require.NoError(t, f.keeper.BuyItem(ctx, buyer, b1, types.BuyOptions{}))
+	require.NoError(t, f.keeper.DelistItem(ctx, alice, a3))
+
+	res, err := qs.ListingsBySeller(ctx, &types.QueryListingsBySellerRequest{Seller: alice.String(), Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{a0, a2}, ids(res.Listings))
+	require.Equal(t, uint64(4), res.Pagination.Total)
+	res, err = qs.ListingsBySeller(ctx, &types.QueryListingsBySellerRequest{Seller: alice.String(), Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{a3, a4}, ids(res.Listings))
+	require.Nil(t, res.Pagination.NextKey)
+
+	// newest first
+	res, err = qs.ListingsBySeller(ctx, &types.QueryListingsBySellerRequest{Seller: alice.String(), Pagination: &query.PageRequest{Limit: 3, Reverse: true}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{a4, a3, a2}, ids(res.Listings))
+	res, err = qs.ListingsBySeller(ctx, &types.QueryListingsBySellerRequest{Seller: alice.String(), Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Reverse: true}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{a0}, ids(res.Listings))
+
+	bought, err := qs.ListingsByBuyer(ctx, &types.QueryListingsByBuyerRequest{Buyer: buyer.String()})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{b1, a2}, ids(bought.Listings))
+	require.Equal(t, uint64(2), bought.Pagination.Total)
+
+	active, err := qs.ListingsByStatus(ctx, &types.QueryListingsByStatusRequest{Status: types.ListingStatus_LISTING_STATUS_ACTIVE})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{a0, a4}, ids(active.Listings))
+	cancelled, err := qs.ListingsByStatus(ctx, &types.QueryListingsByStatusRequest{Status: types.ListingStatus_LISTING_STATUS_CANCELLED, Pagination: &query.PageRequest{Offset: 1}})
+	require.NoError(t, err)
+	require.Empty(t, cancelled.Listings)
+
+	// every buyer of a partially filled listing is indexed, not just the last one
+	other := sdk.AccAddress("other_______________")
+	f.bankKeeper.fund(bob, sdk.NewCoins(sdk.NewInt64Coin("token", 10)))
+	f.bankKeeper.fund(other, sdk.NewCoins(sdk.NewInt64Coin("stake", 10)))
+	partial, err := f.keeper.ListItem(ctx, bob, types.ListingTerms{Title: "t", Description: "d", Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), PartialFills: true})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.BuyItem(ctx, buyer, partial, types.BuyOptions{Quantity: sdkmath.NewInt(4)}))
+	require.NoError(t, f.keeper.BuyItem(ctx, other, partial, types.BuyOptions{}))
+	bought, err = qs.ListingsByBuyer(ctx, &types.QueryListingsByBuyerRequest{Buyer: buyer.String()})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{b1, a2, partial}, ids(bought.Listings))
+	bought, err = qs.ListingsByBuyer(ctx, &types.QueryListingsByBuyerRequest{Buyer: other.String()})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{partial}, ids(bought.Listings))
+
+	_, err = qs.ListingsBySeller(ctx, &types.QueryListingsBySellerRequest{})
+	require.Error(t, err)
+	_, err = qs.ListingsBySeller(ctx, &types.QueryListingsBySellerRequest{Seller: alice.String(), Pagination: &query.PageRequest{Key: []byte{1, 2, 3}}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = qs.ListingsByBuyer(ctx, &types.QueryListingsByBuyerRequest{Buyer: buyer.String(), Pagination: &query.PageRequest{Key: []byte{1}, Offset: 1}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
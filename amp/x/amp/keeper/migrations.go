@@ -0,0 +1,181 @@
+package keeper
+
+import (
+    "cosmossdk.io/collections"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+
+    "amp/x/amp/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+    keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+    return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates listings from a single asset coin to coin bundles. The asset of a
+// listing that was not sold is copied into RemainingAssets, as nothing of it was sold, and
+// zero-amount coins are dropped from the asset so it is a valid sdk.Coins. It also sets
+// the MaxExpiredListingsPerBlock, MaxSettledAuctionsPerBlock and MaxOpenOffersPerListing
+// params to their defaults.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+    err := m.migrateParams(ctx, func(params *types.Params, defaults types.Params) {
+        params.MaxExpiredListingsPerBlock = defaults.MaxExpiredListingsPerBlock
+        params.MaxSettledAuctionsPerBlock = defaults.MaxSettledAuctionsPerBlock
+        params.MaxOpenOffersPerListing = defaults.MaxOpenOffersPerListing
+    })
+    if err != nil {
+        return err
+    }
+
+    var listings []types.Listing
+    err = m.keeper.Listings.Walk(ctx, nil, func(_ uint64, listing types.Listing) (bool, error) {
+        listings = append(listings, listing)
+        return false, nil
+    })
+    if err != nil {
+        return err
+    }
+
+    for _, listing := range listings {
+        if listing.Status == types.ListingStatus_LISTING_STATUS_SOLD {
+            listing.RemainingAssets = sdk.Coins{}
+        } else {
+            listing.RemainingAssets = sdk.NewCoins(listing.Asset...)
+        }
+        listing.Asset = sdk.NewCoins(listing.Asset...)
+
+        if err := m.keeper.Listings.Set(ctx, listing.Id, listing); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+// Migrate2to3 counts the open listings of every seller for Params.MaxActiveListingsPerSeller.
+// Listings opened before carry no deposit. It also sets the DeliveryTimeout,
+// MaxReleasedDeliveriesPerBlock, DisputeTimeout, DisputeSellerBondRate, MaxBatchSize and
+// MaxRoyaltyRate params to their defaults, and the commission shares of FeeSplit and
+// ReferralShare to zero where unset.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+    err := m.migrateParams(ctx, func(params *types.Params, defaults types.Params) {
+        params.DeliveryTimeout = defaults.DeliveryTimeout
+        params.MaxReleasedDeliveriesPerBlock = defaults.MaxReleasedDeliveriesPerBlock
+        params.DisputeTimeout = defaults.DisputeTimeout
+        params.DisputeSellerBondRate = defaults.DisputeSellerBondRate
+        params.MaxBatchSize = defaults.MaxBatchSize
+        params.MaxRoyaltyRate = defaults.MaxRoyaltyRate
+        if params.FeeSplit.CommunityPool.IsNil() {
+            params.FeeSplit.CommunityPool = defaults.FeeSplit.CommunityPool
+        }
+        if params.FeeSplit.Burn.IsNil() {
+            params.FeeSplit.Burn = defaults.FeeSplit.Burn
+        }
+        if params.FeeSplit.Treasury.IsNil() {
+            params.FeeSplit.Treasury = defaults.FeeSplit.Treasury
+        }
+        if params.ReferralShare.IsNil() {
+            params.ReferralShare = defaults.ReferralShare
+        }
+    })
+    if err != nil {
+        return err
+    }
+
+    counts := make(map[string]uint64)
+    var sellers []string
+    err = m.keeper.Listings.Walk(ctx, nil, func(_ uint64, listing types.Listing) (bool, error) {
+        if listing.IsOpen() {
+            if counts[listing.Seller] == 0 {
+                sellers = append(sellers, listing.Seller)
+            }
+            counts[listing.Seller]++
+        }
+        return false, nil
+    })
+    if err != nil {
+        return err
+    }
+
+    for _, seller := range sellers {
+        if err := m.keeper.ActiveListingCounts.Set(ctx, seller, counts[seller]); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+// Migrate3to4 builds the seller and status indexes of the listings, and the buyer index
+// from the buyer of every sold listing.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+    if err := m.reindexListings(ctx); err != nil {
+        return err
+    }
+
+    var bought []collections.Pair[string, uint64]
+    err := m.keeper.Listings.Walk(ctx, nil, func(id uint64, listing types.Listing) (bool, error) {
+        if listing.Buyer != "" {
+            bought = append(bought, collections.Join(listing.Buyer, id))
+        }
+        return false, nil
+    })
+    if err != nil {
+        return err
+    }
+    for _, key := range bought {
+        if err := m.keeper.ListingsByBuyer.Set(ctx, key); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+// Migrate4to5 builds the price index of the open listings.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+    return m.reindexListings(ctx)
+}
+
+// Migrate5to6 sets the StatsEpochIdentifier, MaxTwapWindow and StatsSnapshotRetention params
+// to their defaults.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+    return m.migrateParams(ctx, func(params *types.Params, defaults types.Params) {
+        params.StatsEpochIdentifier = defaults.StatsEpochIdentifier
+        params.MaxTwapWindow = defaults.MaxTwapWindow
+        params.StatsSnapshotRetention = defaults.StatsSnapshotRetention
+    })
+}
+
+// migrateParams fills in the params a migration adds, which decode as zero values from the
+// params stored before it, from the defaults.
+func (m Migrator) migrateParams(ctx sdk.Context, fill func(params *types.Params, defaults types.Params)) error {
+    params, err := m.keeper.Params.Get(ctx)
+    if err != nil {
+        return err
+    }
+    fill(&params, types.DefaultParams())
+    return m.keeper.Params.Set(ctx, params)
+}
+
+// reindexListings writes every listing back through the indexed map, which builds any
+// listing index that is missing entries.
+func (m Migrator) reindexListings(ctx sdk.Context) error {
+    var listings []types.Listing
+    err := m.keeper.Listings.Walk(ctx, nil, func(_ uint64, listing types.Listing) (bool, error) {
+        listings = append(listings, listing)
+        return false, nil
+    })
+    if err != nil {
+        return err
+    }
+
+    for _, listing := range listings {
+        if err := m.keeper.Listings.Set(ctx, listing.Id, listing); err != nil {
+            return err
+        }
+    }
+    return nil
+}
@@ -0,0 +1,191 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"amp/x/amp/keeper"
+	"amp/x/amp/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	legacy := []types.Listing{
+		{Id: 1, Asset: sdk.Coins{sdk.NewInt64Coin("token", 10)}, Status: types.ListingStatus_LISTING_STATUS_ACTIVE},
+		{Id: 2, Asset: sdk.Coins{sdk.NewInt64Coin("token", 10)}, Status: types.ListingStatus_LISTING_STATUS_CANCELLED},
+		{Id: 3, Asset: sdk.Coins{sdk.NewInt64Coin("token", 10)}, Status: types.ListingStatus_LISTING_STATUS_SOLD},
+		{Id: 4, Asset: sdk.Coins{sdk.NewInt64Coin("token", 0)}, Status: types.ListingStatus_LISTING_STATUS_CANCELLED},
+	}
+	for _, listing := range legacy {
+		require.NoError(t, f.keeper.Listings.Set(ctx, listing.Id, listing))
+	}
+	// params stored before MaxExpiredListingsPerBlock existed
+	require.NoError(t, f.keeper.Params.Set(ctx, types.Params{CommissionRate: sdkmath.LegacyNewDecWithPrec(2, 2)}))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(ctx))
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultMaxExpiredListingsPerBlock, params.MaxExpiredListingsPerBlock)
+	require.Equal(t, types.DefaultMaxSettledAuctionsPerBlock, params.MaxSettledAuctionsPerBlock)
+	require.Equal(t, types.DefaultMaxOpenOffersPerListing, params.MaxOpenOffersPerListing)
+	require.Equal(t, sdkmath.LegacyNewDecWithPrec(2, 2), params.CommissionRate)
+
+	expected := map[uint64]sdk.Coins{
+		1: sdk.NewCoins(sdk.NewInt64Coin("token", 10)),
+		2: sdk.NewCoins(sdk.NewInt64Coin("token", 10)),
+		3: sdk.Coins{},
+		4: sdk.Coins{},
+	}
+	for id, remaining := range expected {
+		listing, found := f.keeper.GetListing(ctx, id)
+		require.True(t, found)
+		require.True(t, remaining.Equal(listing.RemainingAssets), "listing %d", id)
+		require.NoError(t, listing.Asset.Validate())
+	}
+}
+
+func TestMigrate2to3(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	listings := []types.Listing{
+		{Id: 1, Seller: "alice", Status: types.ListingStatus_LISTING_STATUS_ACTIVE},
+		{Id: 2, Seller: "alice", Status: types.ListingStatus_LISTING_STATUS_PARTIALLY_FILLED},
+		{Id: 3, Seller: "alice", Status: types.ListingStatus_LISTING_STATUS_SOLD},
+		{Id: 4, Seller: "bob", Status: types.ListingStatus_LISTING_STATUS_ACTIVE},
+		{Id: 5, Seller: "carol", Status: types.ListingStatus_LISTING_STATUS_CANCELLED},
+	}
+	for _, listing := range listings {
+		require.NoError(t, f.keeper.Listings.Set(ctx, listing.Id, listing))
+	}
+	// params stored before the delivery, batch, royalty, fee split and referral params existed
+	require.NoError(t, f.keeper.Params.Set(ctx, types.Params{
+		CommissionRate:             sdkmath.LegacyNewDecWithPrec(2, 2),
+		MaxExpiredListingsPerBlock: 7,
+	}))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate2to3(ctx))
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint32(7), params.MaxExpiredListingsPerBlock)
+	require.Equal(t, types.DefaultDeliveryTimeout, params.DeliveryTimeout)
+	require.Equal(t, types.DefaultMaxReleasedDeliveriesPerBlock, params.MaxReleasedDeliveriesPerBlock)
+	require.Equal(t, types.DefaultDisputeTimeout, params.DisputeTimeout)
+	require.Equal(t, types.DefaultDisputeSellerBondRate, params.DisputeSellerBondRate)
+	require.Equal(t, types.DefaultMaxBatchSize, params.MaxBatchSize)
+	require.Equal(t, types.DefaultMaxRoyaltyRate, params.MaxRoyaltyRate)
+	require.True(t, params.FeeSplit.CommunityPool.IsZero())
+	require.True(t, params.FeeSplit.Burn.IsZero())
+	require.True(t, params.FeeSplit.Treasury.IsZero())
+	require.True(t, params.ReferralShare.IsZero())
+	require.NoError(t, params.Validate())
+
+	count, err := f.keeper.ActiveListingCounts.Get(ctx, "alice")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), count)
+	count, err = f.keeper.ActiveListingCounts.Get(ctx, "bob")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), count)
+	has, err := f.keeper.ActiveListingCounts.Has(ctx, "carol")
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestMigrate3to4(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	listings := []types.Listing{
+		{Id: 1, Seller: "alice", Status: types.ListingStatus_LISTING_STATUS_ACTIVE},
+		{Id: 2, Seller: "alice", Buyer: "bob", Status: types.ListingStatus_LISTING_STATUS_SOLD},
+		{Id: 3, Seller: "carol", Status: types.ListingStatus_LISTING_STATUS_ACTIVE},
+	}
+	// listings stored before the indexes existed
+	for _, listing := range listings {
+		require.NoError(t, f.keeper.Listings.Set(ctx, listing.Id, listing))
+		getListing := func() (types.Listing, error) { return listing, nil }
+		require.NoError(t, f.keeper.Listings.Indexes.Seller.Unreference(ctx, listing.Id, getListing))
+		require.NoError(t, f.keeper.Listings.Indexes.Status.Unreference(ctx, listing.Id, getListing))
+	}
+	res, err := qs.ListingsBySeller(ctx, &types.QueryListingsBySellerRequest{Seller: "alice"})
+	require.NoError(t, err)
+	require.Empty(t, res.Listings)
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate3to4(ctx))
+
+	res, err = qs.ListingsBySeller(ctx, &types.QueryListingsBySellerRequest{Seller: "alice"})
+	require.NoError(t, err)
+	require.Len(t, res.Listings, 2)
+	active, err := qs.ListingsByStatus(ctx, &types.QueryListingsByStatusRequest{Status: types.ListingStatus_LISTING_STATUS_ACTIVE})
+	require.NoError(t, err)
+	require.Len(t, active.Listings, 2)
+	bought, err := qs.ListingsByBuyer(ctx, &types.QueryListingsByBuyerRequest{Buyer: "bob"})
+	require.NoError(t, err)
+	require.Len(t, bought.Listings, 1)
+	require.Equal(t, uint64(2), bought.Listings[0].Id)
+}
+
+func TestMigrate4to5(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	token := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("token", amount)) }
+	stake := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("stake", amount)) }
+	listings := []types.Listing{
+		{Id: 1, Seller: "alice", Asset: token(10), RemainingAssets: token(10), Price: stake(30), Status: types.ListingStatus_LISTING_STATUS_ACTIVE},
+		{Id: 2, Seller: "alice", Asset: token(10), RemainingAssets: token(4), Price: stake(2), PartialFills: true, Status: types.ListingStatus_LISTING_STATUS_PARTIALLY_FILLED},
+		{Id: 3, Seller: "bob", Asset: token(10), RemainingAssets: sdk.Coins{}, Price: stake(1), Buyer: "carol", Status: types.ListingStatus_LISTING_STATUS_SOLD},
+	}
+	// listings stored before the price index existed
+	for _, listing := range listings {
+		require.NoError(t, f.keeper.Listings.Set(ctx, listing.Id, listing))
+		getListing := func() (types.Listing, error) { return listing, nil }
+		require.NoError(t, f.keeper.Listings.Indexes.Price.Unreference(ctx, listing.Id, getListing))
+	}
+	floor, err := qs.FloorPrice(ctx, &types.QueryFloorPriceRequest{AssetDenom: "token", PriceDenom: "stake"})
+	require.NoError(t, err)
+	require.Nil(t, floor.Floor)
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate4to5(ctx))
+
+	floor, err = qs.FloorPrice(ctx, &types.QueryFloorPriceRequest{AssetDenom: "token", PriceDenom: "stake"})
+	require.NoError(t, err)
+	require.Equal(t, &types.FloorPrice{UnitPrice: sdk.NewInt64DecCoin("stake", 2), ListingId: 2}, floor.Floor)
+	res, err := qs.ListingsByPrice(ctx, &types.QueryListingsByPriceRequest{AssetDenom: "token", PriceDenom: "stake"})
+	require.NoError(t, err)
+	require.Len(t, res.Listings, 2)
+	require.Equal(t, uint64(2), res.Listings[0].Listing.Id)
+	require.Equal(t, uint64(1), res.Listings[1].Listing.Id)
+	require.Equal(t, sdk.NewInt64DecCoin("stake", 3), res.Listings[1].UnitPrice)
+}
+
+func TestMigrate5to6(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	// params stored before the stats and TWAP params existed
+	legacy := types.DefaultParams()
+	legacy.MaxBatchSize = 3
+	legacy.StatsEpochIdentifier = ""
+	legacy.MaxTwapWindow = 0
+	legacy.StatsSnapshotRetention = 0
+	require.NoError(t, f.keeper.Params.Set(ctx, legacy))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate5to6(ctx))
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint32(3), params.MaxBatchSize)
+	require.Equal(t, types.DefaultStatsEpochIdentifier, params.StatsEpochIdentifier)
+	require.Equal(t, types.DefaultMaxTWAPWindow, params.MaxTwapWindow)
+	require.Equal(t, types.DefaultStatsSnapshotRetention, params.StatsSnapshotRetention)
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+    "context"
+
+    sdk "github.com/cosmos/cosmos-sdk/types"
+
+    "amp/x/amp/types"
+)
+
+func (m msgServer) CreateAuction(ctx context.Context, req *types.MsgCreateAuction) (*types.MsgCreateAuctionResponse, error) {
+    sellerBz, err := m.addressCodec.StringToBytes(req.Seller)
+    if err != nil {
+        return nil, err
+    }
+    seller := sdk.AccAddress(sellerBz)
+
+    id, err := m.Keeper.CreateAuction(ctx, seller, req.Title, req.Description, req.Asset, req.MinBid, req.EndTime)
+    if err != nil {
+        return nil, err
+    }
+
+    return &types.MsgCreateAuctionResponse{Id: id}, nil
+}
+
+func (m msgServer) PlaceBid(ctx context.Context, req *types.MsgPlaceBid) (*types.MsgPlaceBidResponse, error) {
+    bidderBz, err := m.addressCodec.StringToBytes(req.Bidder)
+    if err != nil {
+        return nil, err
+    }
+    bidder := sdk.AccAddress(bidderBz)
+
+    if err := m.Keeper.PlaceBid(ctx, bidder, req.AuctionId, req.Amount); err != nil {
+        return nil, err
+    }
+    return &types.MsgPlaceBidResponse{}, nil
+}
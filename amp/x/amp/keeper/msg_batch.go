@@ -0,0 +1,37 @@
+package keeper
+
+import (
+    "context"
+
+    sdk "github.com/cosmos/cosmos-sdk/types"
+
+    "amp/x/amp/types"
+)
+
+func (m msgServer) BatchBuy(ctx context.Context, req *types.MsgBatchBuy) (*types.MsgBatchBuyResponse, error) {
+    buyerBz, err := m.addressCodec.StringToBytes(req.Buyer)
+    if err != nil {
+        return nil, err
+    }
+    buyer := sdk.AccAddress(buyerBz)
+
+    total, err := m.Keeper.BatchBuy(ctx, buyer, req.Items, req.MaxTotal)
+    if err != nil {
+        return nil, err
+    }
+    return &types.MsgBatchBuyResponse{Total: total}, nil
+}
+
+func (m msgServer) BatchList(ctx context.Context, req *types.MsgBatchList) (*types.MsgBatchListResponse, error) {
+    sellerBz, err := m.addressCodec.StringToBytes(req.Seller)
+    if err != nil {
+        return nil, err
+    }
+    seller := sdk.AccAddress(sellerBz)
+
+    ids, err := m.Keeper.BatchList(ctx, seller, req.Items)
+    if err != nil {
+        return nil, err
+    }
+    return &types.MsgBatchListResponse{Ids: ids}, nil
+}
@@ -0,0 +1,18 @@
+package keeper
+
+import (
+    "context"
+
+    "amp/x/amp/types"
+)
+
+func (m msgServer) UpdateCategories(ctx context.Context, req *types.MsgUpdateCategories) (*types.MsgUpdateCategoriesResponse, error) {
+    if err := m.checkAuthority(req.Authority); err != nil {
+        return nil, err
+    }
+
+    if err := m.Keeper.UpdateCategories(ctx, req.Add, req.Remove); err != nil {
+        return nil, err
+    }
+    return &types.MsgUpdateCategoriesResponse{}, nil
+}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+    "context"
+
+    sdk "github.com/cosmos/cosmos-sdk/types"
+
+    "amp/x/amp/types"
+)
+
+func (m msgServer) ConfirmDelivery(ctx context.Context, req *types.MsgConfirmDelivery) (*types.MsgConfirmDeliveryResponse, error) {
+    buyerBz, err := m.addressCodec.StringToBytes(req.Buyer)
+    if err != nil {
+        return nil, err
+    }
+    buyer := sdk.AccAddress(buyerBz)
+
+    if err := m.Keeper.ConfirmDelivery(ctx, buyer, req.ListingId); err != nil {
+        return nil, err
+    }
+    return &types.MsgConfirmDeliveryResponse{}, nil
+}
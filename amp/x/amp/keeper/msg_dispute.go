@@ -0,0 +1,46 @@
+package keeper
+
+import (
+    "context"
+
+    sdk "github.com/cosmos/cosmos-sdk/types"
+
+    "amp/x/amp/types"
+)
+
+func (m msgServer) OpenDispute(ctx context.Context, req *types.MsgOpenDispute) (*types.MsgOpenDisputeResponse, error) {
+    buyerBz, err := m.addressCodec.StringToBytes(req.Buyer)
+    if err != nil {
+        return nil, err
+    }
+    buyer := sdk.AccAddress(buyerBz)
+
+    if err := m.Keeper.OpenDispute(ctx, buyer, req.ListingId, req.Reason, req.EvidenceUri); err != nil {
+        return nil, err
+    }
+    return &types.MsgOpenDisputeResponse{}, nil
+}
+
+func (m msgServer) ResolveDispute(ctx context.Context, req *types.MsgResolveDispute) (*types.MsgResolveDisputeResponse, error) {
+    arbiterBz, err := m.addressCodec.StringToBytes(req.Arbiter)
+    if err != nil {
+        return nil, err
+    }
+    arbiter := sdk.AccAddress(arbiterBz)
+
+    if err := m.Keeper.ResolveDispute(ctx, arbiter, req.ListingId, req.BuyerRatio); err != nil {
+        return nil, err
+    }
+    return &types.MsgResolveDisputeResponse{}, nil
+}
+
+func (m msgServer) UpdateArbiters(ctx context.Context, req *types.MsgUpdateArbiters) (*types.MsgUpdateArbitersResponse, error) {
+    if err := m.checkAuthority(req.Authority); err != nil {
+        return nil, err
+    }
+
+    if err := m.Keeper.UpdateArbiters(ctx, req.Add, req.Remove); err != nil {
+        return nil, err
+    }
+    return &types.MsgUpdateArbitersResponse{}, nil
+}
@@ -15,8 +15,26 @@ func (m msgServer) ListItem(ctx context.Context, req *types.MsgListItem) (*types
         return nil, err
     }
     seller := sdk.AccAddress(sellerBz)
+    referrer, err := m.optionalAddress(req.Referrer)
+    if err != nil {
+        return nil, err
+    }
 
-    id, err := m.Keeper.ListItem(ctx, seller, req.Title, req.Description, req.Asset, req.Price)
+    id, err := m.Keeper.ListItem(ctx, seller, types.ListingTerms{
+        Title:          req.Title,
+        Description:    req.Description,
+        Asset:          req.Asset,
+        Price:          req.Price,
+        Dutch:          req.Dutch,
+        ExpiresAt:      req.ExpiresAt,
+        PartialFills:   req.PartialFills,
+        Restriction:    req.Restriction,
+        NFT:            req.Nft,
+        DeliveryEscrow: req.DeliveryEscrow,
+        Referrer:       referrer,
+        Category:       req.Category,
+        Tags:           req.Tags,
+    })
     if err != nil {
         return nil, err
     }
@@ -30,13 +48,38 @@ func (m msgServer) BuyItem(ctx context.Context, req *types.MsgBuyItem) (*types.M
         return nil, err
     }
     buyer := sdk.AccAddress(buyerBz)
+    referrer, err := m.optionalAddress(req.Referrer)
+    if err != nil {
+        return nil, err
+    }
 
-    if err := m.Keeper.BuyItem(ctx, buyer, req.ListingId); err != nil {
+    err = m.Keeper.BuyItem(ctx, buyer, req.ListingId, types.BuyOptions{
+        Quantity:         req.Quantity,
+        Denom:            req.Denom,
+        Proof:            req.Proof,
+        MaxTotal:         req.MaxTotal,
+        MaxFee:           req.MaxFee,
+        ExpectedRevision: req.ExpectedRevision,
+        Referrer:         referrer,
+    })
+    if err != nil {
         return nil, err
     }
     return &types.MsgBuyItemResponse{}, nil
 }
 
+// optionalAddress decodes addr, or returns nil if it is empty.
+func (m msgServer) optionalAddress(addr string) (sdk.AccAddress, error) {
+    if addr == "" {
+        return nil, nil
+    }
+    bz, err := m.addressCodec.StringToBytes(addr)
+    if err != nil {
+        return nil, err
+    }
+    return sdk.AccAddress(bz), nil
+}
+
 func (m msgServer) DelistItem(ctx context.Context, req *types.MsgDelistItem) (*types.MsgDelistItemResponse, error) {
     sellerBz, err := m.addressCodec.StringToBytes(req.Seller)
     if err != nil {
@@ -49,3 +92,17 @@ func (m msgServer) DelistItem(ctx context.Context, req *types.MsgDelistItem) (*t
     }
     return &types.MsgDelistItemResponse{}, nil
 }
+
+func (m msgServer) UpdateListing(ctx context.Context, req *types.MsgUpdateListing) (*types.MsgUpdateListingResponse, error) {
+    sellerBz, err := m.addressCodec.StringToBytes(req.Seller)
+    if err != nil {
+        return nil, err
+    }
+    seller := sdk.AccAddress(sellerBz)
+
+    revision, err := m.Keeper.UpdateListing(ctx, seller, req.ListingId, req.Title, req.Description, req.Price, req.Asset)
+    if err != nil {
+        return nil, err
+    }
+    return &types.MsgUpdateListingResponse{Revision: revision}, nil
+}
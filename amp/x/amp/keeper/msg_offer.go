@@ -0,0 +1,50 @@
+package keeper
+
+import (
+    "context"
+
+    sdk "github.com/cosmos/cosmos-sdk/types"
+
+    "amp/x/amp/types"
+)
+
+func (m msgServer) MakeOffer(ctx context.Context, req *types.MsgMakeOffer) (*types.MsgMakeOfferResponse, error) {
+    buyerBz, err := m.addressCodec.StringToBytes(req.Buyer)
+    if err != nil {
+        return nil, err
+    }
+    buyer := sdk.AccAddress(buyerBz)
+
+    id, err := m.Keeper.MakeOffer(ctx, buyer, req.ListingId, req.Amount, req.ExpiresAt)
+    if err != nil {
+        return nil, err
+    }
+
+    return &types.MsgMakeOfferResponse{Id: id}, nil
+}
+
+func (m msgServer) AcceptOffer(ctx context.Context, req *types.MsgAcceptOffer) (*types.MsgAcceptOfferResponse, error) {
+    sellerBz, err := m.addressCodec.StringToBytes(req.Seller)
+    if err != nil {
+        return nil, err
+    }
+    seller := sdk.AccAddress(sellerBz)
+
+    if err := m.Keeper.AcceptOffer(ctx, seller, req.OfferId); err != nil {
+        return nil, err
+    }
+    return &types.MsgAcceptOfferResponse{}, nil
+}
+
+func (m msgServer) CancelOffer(ctx context.Context, req *types.MsgCancelOffer) (*types.MsgCancelOfferResponse, error) {
+    buyerBz, err := m.addressCodec.StringToBytes(req.Buyer)
+    if err != nil {
+        return nil, err
+    }
+    buyer := sdk.AccAddress(buyerBz)
+
+    if err := m.Keeper.CancelOffer(ctx, buyer, req.OfferId); err != nil {
+        return nil, err
+    }
+    return &types.MsgCancelOfferResponse{}, nil
+}
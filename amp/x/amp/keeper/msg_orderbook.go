@@ -0,0 +1,36 @@
+package keeper
+
+import (
+    "context"
+
+    sdk "github.com/cosmos/cosmos-sdk/types"
+
+    "amp/x/amp/types"
+)
+
+func (m msgServer) PlaceOrder(ctx context.Context, req *types.MsgPlaceOrder) (*types.MsgPlaceOrderResponse, error) {
+    ownerBz, err := m.addressCodec.StringToBytes(req.Owner)
+    if err != nil {
+        return nil, err
+    }
+    owner := sdk.AccAddress(ownerBz)
+
+    id, filled, err := m.Keeper.PlaceOrder(ctx, owner, req.Side, req.AssetDenom, req.UnitPrice, req.Quantity)
+    if err != nil {
+        return nil, err
+    }
+    return &types.MsgPlaceOrderResponse{Id: id, Filled: filled}, nil
+}
+
+func (m msgServer) CancelOrder(ctx context.Context, req *types.MsgCancelOrder) (*types.MsgCancelOrderResponse, error) {
+    ownerBz, err := m.addressCodec.StringToBytes(req.Owner)
+    if err != nil {
+        return nil, err
+    }
+    owner := sdk.AccAddress(ownerBz)
+
+    if err := m.Keeper.CancelOrder(ctx, owner, req.OrderId); err != nil {
+        return nil, err
+    }
+    return &types.MsgCancelOrderResponse{}, nil
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+    "context"
+
+    sdk "github.com/cosmos/cosmos-sdk/types"
+
+    "amp/x/amp/types"
+)
+
+func (m msgServer) SetRoyalty(ctx context.Context, req *types.MsgSetRoyalty) (*types.MsgSetRoyaltyResponse, error) {
+    creatorBz, err := m.addressCodec.StringToBytes(req.Creator)
+    if err != nil {
+        return nil, err
+    }
+    recipientBz, err := m.addressCodec.StringToBytes(req.Recipient)
+    if err != nil {
+        return nil, err
+    }
+
+    if err := m.Keeper.SetRoyalty(ctx, sdk.AccAddress(creatorBz), req.Kind, req.Asset, sdk.AccAddress(recipientBz), req.Rate); err != nil {
+        return nil, err
+    }
+    return &types.MsgSetRoyaltyResponse{}, nil
+}
+
+func (m msgServer) SetCreator(ctx context.Context, req *types.MsgSetCreator) (*types.MsgSetCreatorResponse, error) {
+    if err := m.checkAuthority(req.Authority); err != nil {
+        return nil, err
+    }
+
+    if err := m.Keeper.SetCreator(ctx, req.Kind, req.Asset, req.Creator); err != nil {
+        return nil, err
+    }
+    return &types.MsgSetCreatorResponse{}, nil
+}
@@ -0,0 +1,18 @@
+package keeper
+
+import (
+    "context"
+
+    "amp/x/amp/types"
+)
+
+func (m msgServer) TakedownListing(ctx context.Context, req *types.MsgTakedownListing) (*types.MsgTakedownListingResponse, error) {
+    if err := m.checkAuthority(req.Authority); err != nil {
+        return nil, err
+    }
+
+    if err := m.Keeper.TakedownListing(ctx, req.ListingId, req.Reason); err != nil {
+        return nil, err
+    }
+    return &types.MsgTakedownListingResponse{}, nil
+}
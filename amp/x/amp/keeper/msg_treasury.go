@@ -0,0 +1,24 @@
+package keeper
+
+import (
+    "context"
+
+    sdk "github.com/cosmos/cosmos-sdk/types"
+
+    "amp/x/amp/types"
+)
+
+func (m msgServer) SpendTreasury(ctx context.Context, req *types.MsgSpendTreasury) (*types.MsgSpendTreasuryResponse, error) {
+    if err := m.checkAuthority(req.Authority); err != nil {
+        return nil, err
+    }
+    recipientBz, err := m.addressCodec.StringToBytes(req.Recipient)
+    if err != nil {
+        return nil, err
+    }
+
+    if err := m.Keeper.SpendTreasury(ctx, sdk.AccAddress(recipientBz), req.Amount); err != nil {
+        return nil, err
+    }
+    return &types.MsgSpendTreasuryResponse{}, nil
+}
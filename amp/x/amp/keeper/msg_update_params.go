@@ -10,14 +10,8 @@ import (
 )
 
 func (k msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
-	authority, err := k.addressCodec.StringToBytes(req.Authority)
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "invalid authority address")
-	}
-
-	if !bytes.Equal(k.GetAuthority(), authority) {
-		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
-		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, req.Authority)
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
 	}
 
 	if err := req.Params.Validate(); err != nil {
@@ -30,3 +24,17 @@ func (k msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// checkAuthority returns ErrInvalidSigner unless authority is the module's authority.
+func (k msgServer) checkAuthority(authority string) error {
+	authorityBz, err := k.addressCodec.StringToBytes(authority)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !bytes.Equal(k.GetAuthority(), authorityBz) {
+		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
+		return errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, authority)
+	}
+	return nil
+}
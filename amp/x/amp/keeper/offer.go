@@ -0,0 +1,321 @@
+package keeper
+
+import (
+    "context"
+    "fmt"
+
+    "cosmossdk.io/collections"
+    errorsmod "cosmossdk.io/errors"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+    authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+    "amp/x/amp/types"
+)
+
+// MakeOffer locks the offered amount into escrow against an active listing and returns the
+// offer ID. A listing holds at most Params.MaxOpenOffersPerListing open offers.
+func (k Keeper) MakeOffer(ctx context.Context, buyer sdk.AccAddress, listingID uint64, amount sdk.Coin, expiresAt int64) (uint64, error) {
+    if err := amount.Validate(); err != nil {
+        return 0, err
+    }
+    if !amount.IsPositive() {
+        return 0, errorsmod.Wrap(types.ErrInvalidOffer, "offer amount must be positive")
+    }
+
+    listing, err := k.Listings.Get(ctx, listingID)
+    if err != nil {
+        return 0, err
+    }
+    if !listing.IsOpen() {
+        return 0, types.ErrListingNotActive
+    }
+    if k.isExpired(ctx, listing) {
+        return 0, types.ErrListingExpired
+    }
+    if found, _ := listing.Price.Find(amount.Denom); !found {
+        return 0, errorsmod.Wrapf(types.ErrInvalidOffer, "offer denom must be one of the price options %s", listing.Price)
+    }
+
+    buyerStr, _ := k.addressCodec.BytesToString(buyer)
+    if buyerStr == listing.Seller {
+        return 0, types.ErrSelfPurchase
+    }
+    if err := k.checkOfferLimit(ctx, listingID); err != nil {
+        return 0, err
+    }
+
+    sdkCtx := sdk.UnwrapSDKContext(ctx)
+    t := sdkCtx.BlockTime().Unix()
+    if expiresAt != 0 && expiresAt <= t {
+        return 0, types.ErrInvalidExpiry
+    }
+
+    id, err := k.OfferSeq.Next(ctx)
+    if err != nil {
+        return 0, err
+    }
+
+    // move offered funds to escrow
+    escrow := authtypes.NewModuleAddress(types.EscrowModuleName)
+    if err := k.bankKeeper.SendCoins(ctx, buyer, escrow, sdk.NewCoins(amount)); err != nil {
+        return 0, err
+    }
+
+    offer := types.Offer{
+        Id:        id,
+        ListingId: listingID,
+        Buyer:     buyerStr,
+        Amount:    amount,
+        Status:    types.OfferStatus_OFFER_STATUS_OPEN,
+        CreatedAt: t,
+        ExpiresAt: expiresAt,
+    }
+    if err := k.Offers.Set(ctx, id, offer); err != nil {
+        return 0, err
+    }
+    if err := k.OffersByListing.Set(ctx, collections.Join(listingID, id)); err != nil {
+        return 0, err
+    }
+    if err := k.OffersByBuyer.Set(ctx, collections.Join(buyerStr, id)); err != nil {
+        return 0, err
+    }
+    if expiresAt != 0 {
+        if err := k.OffersByExpiry.Set(ctx, collections.Join(expiresAt, id)); err != nil {
+            return 0, err
+        }
+    }
+
+    _ = sdkCtx.EventManager().EmitTypedEvent(&types.EventOfferMade{
+        Id:        id,
+        ListingId: listingID,
+        Buyer:     buyerStr,
+        Amount:    amount,
+        ExpiresAt: expiresAt,
+    })
+    sdkCtx.EventManager().EmitEvent(
+        sdk.NewEvent(
+            types.EventTypeOfferMade,
+            sdk.NewAttribute(types.AttributeKeyOfferID, fmt.Sprintf("%d", id)),
+            sdk.NewAttribute(types.AttributeKeyListingID, fmt.Sprintf("%d", listingID)),
+            sdk.NewAttribute(types.AttributeKeyBuyer, buyerStr),
+            sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+            sdk.NewAttribute(types.AttributeKeyExpiresAt, fmt.Sprintf("%d", expiresAt)),
+        ),
+    )
+    return id, nil
+}
+
+// AcceptOffer sells everything remaining on the offer's listing to the offering buyer,
+// paying the seller from the escrowed offer with the same commission split as BuyItem.
+func (k Keeper) AcceptOffer(ctx context.Context, seller sdk.AccAddress, offerID uint64) error {
+    offer, err := k.Offers.Get(ctx, offerID)
+    if err != nil {
+        return err
+    }
+    if offer.Status != types.OfferStatus_OFFER_STATUS_OPEN {
+        return types.ErrOfferNotOpen
+    }
+    sdkCtx := sdk.UnwrapSDKContext(ctx)
+    if offer.ExpiresAt != 0 && offer.ExpiresAt <= sdkCtx.BlockTime().Unix() {
+        return types.ErrOfferExpired
+    }
+
+    listing, err := k.Listings.Get(ctx, offer.ListingId)
+    if err != nil {
+        return err
+    }
+    if !listing.IsOpen() {
+        return types.ErrListingNotActive
+    }
+    if k.isExpired(ctx, listing) {
+        return types.ErrListingExpired
+    }
+
+    sellerStr, _ := k.addressCodec.BytesToString(seller)
+    if sellerStr != listing.Seller {
+        return types.ErrUnauthorized
+    }
+
+    buyerBz, err := k.addressCodec.StringToBytes(offer.Buyer)
+    if err != nil {
+        return err
+    }
+
+    // close the offer first so the sale does not refund it
+    if err := k.closeOffer(ctx, offer, types.OfferStatus_OFFER_STATUS_ACCEPTED); err != nil {
+        return err
+    }
+
+    escrow := authtypes.NewModuleAddress(types.EscrowModuleName)
+    if err := k.completeSale(ctx, listing, escrow, sdk.AccAddress(buyerBz), offer.Amount, listing.RemainingAssets, ""); err != nil {
+        return err
+    }
+
+    _ = sdkCtx.EventManager().EmitTypedEvent(&types.EventOfferAccepted{
+        Id:        offerID,
+        ListingId: listing.Id,
+        Seller:    sellerStr,
+        Buyer:     offer.Buyer,
+        Amount:    offer.Amount,
+    })
+    sdkCtx.EventManager().EmitEvent(
+        sdk.NewEvent(
+            types.EventTypeOfferAccepted,
+            sdk.NewAttribute(types.AttributeKeyOfferID, fmt.Sprintf("%d", offerID)),
+            sdk.NewAttribute(types.AttributeKeyListingID, fmt.Sprintf("%d", listing.Id)),
+            sdk.NewAttribute(types.AttributeKeySeller, sellerStr),
+            sdk.NewAttribute(types.AttributeKeyBuyer, offer.Buyer),
+            sdk.NewAttribute(types.AttributeKeyAmount, offer.Amount.String()),
+        ),
+    )
+    return nil
+}
+
+// CancelOffer withdraws an open offer, only by its buyer, and refunds the escrowed amount.
+func (k Keeper) CancelOffer(ctx context.Context, buyer sdk.AccAddress, offerID uint64) error {
+    offer, err := k.Offers.Get(ctx, offerID)
+    if err != nil {
+        return err
+    }
+    if offer.Status != types.OfferStatus_OFFER_STATUS_OPEN {
+        return types.ErrOfferNotOpen
+    }
+
+    buyerStr, _ := k.addressCodec.BytesToString(buyer)
+    if buyerStr != offer.Buyer {
+        return types.ErrUnauthorized
+    }
+
+    return k.refundOffer(ctx, offer, types.OfferStatus_OFFER_STATUS_CANCELLED)
+}
+
+// refundOffer returns an open offer's escrowed amount to its buyer and closes it with status.
+func (k Keeper) refundOffer(ctx context.Context, offer types.Offer, status types.OfferStatus) error {
+    buyerBz, err := k.addressCodec.StringToBytes(offer.Buyer)
+    if err != nil {
+        return err
+    }
+
+    escrow := authtypes.NewModuleAddress(types.EscrowModuleName)
+    if err := k.bankKeeper.SendCoins(ctx, escrow, sdk.AccAddress(buyerBz), sdk.NewCoins(offer.Amount)); err != nil {
+        return err
+    }
+    if err := k.closeOffer(ctx, offer, status); err != nil {
+        return err
+    }
+
+    sdkCtx := sdk.UnwrapSDKContext(ctx)
+    _ = sdkCtx.EventManager().EmitTypedEvent(&types.EventOfferClosed{
+        Id:        offer.Id,
+        ListingId: offer.ListingId,
+        Buyer:     offer.Buyer,
+        Refund:    offer.Amount,
+        Status:    status,
+    })
+    sdkCtx.EventManager().EmitEvent(
+        sdk.NewEvent(
+            types.EventTypeOfferClosed,
+            sdk.NewAttribute(types.AttributeKeyOfferID, fmt.Sprintf("%d", offer.Id)),
+            sdk.NewAttribute(types.AttributeKeyListingID, fmt.Sprintf("%d", offer.ListingId)),
+            sdk.NewAttribute(types.AttributeKeyBuyer, offer.Buyer),
+            sdk.NewAttribute(types.AttributeKeyAmount, offer.Amount.String()),
+            sdk.NewAttribute(types.AttributeKeyStatus, status.String()),
+        ),
+    )
+    return nil
+}
+
+// closeOffer stores the offer's final status and drops it from the listing, buyer and expiry
+// indexes, which only hold open offers.
+func (k Keeper) closeOffer(ctx context.Context, offer types.Offer, status types.OfferStatus) error {
+    offer.Status = status
+    if err := k.Offers.Set(ctx, offer.Id, offer); err != nil {
+        return err
+    }
+    if err := k.OffersByListing.Remove(ctx, collections.Join(offer.ListingId, offer.Id)); err != nil {
+        return err
+    }
+    if err := k.OffersByBuyer.Remove(ctx, collections.Join(offer.Buyer, offer.Id)); err != nil {
+        return err
+    }
+    if offer.ExpiresAt != 0 {
+        return k.OffersByExpiry.Remove(ctx, collections.Join(offer.ExpiresAt, offer.Id))
+    }
+    return nil
+}
+
+// checkOfferLimit returns ErrOfferLimitReached if a listing already has
+// Params.MaxOpenOffersPerListing open offers.
+func (k Keeper) checkOfferLimit(ctx context.Context, listingID uint64) error {
+    params, err := k.Params.Get(ctx)
+    if err != nil {
+        return err
+    }
+    limit := params.MaxOpenOffersPerListing
+    if limit == 0 {
+        return nil
+    }
+
+    var count uint32
+    err = k.OffersByListing.Walk(ctx, collections.NewPrefixedPairRange[uint64, uint64](listingID), func(_ collections.Pair[uint64, uint64]) (bool, error) {
+        count++
+        return count >= limit, nil
+    })
+    if err != nil {
+        return err
+    }
+    if count >= limit {
+        return errorsmod.Wrapf(types.ErrOfferLimitReached, "at most %d", limit)
+    }
+    return nil
+}
+
+// refundOpenOffers refunds every offer still open on a listing that was sold or delisted,
+// which Params.MaxOpenOffersPerListing bounds.
+func (k Keeper) refundOpenOffers(ctx context.Context, listingID uint64) error {
+    it, err := k.OffersByListing.Iterate(ctx, collections.NewPrefixedPairRange[uint64, uint64](listingID))
+    if err != nil {
+        return err
+    }
+    keys, err := it.Keys()
+    if err != nil {
+        return err
+    }
+
+    for _, key := range keys {
+        offer, err := k.Offers.Get(ctx, key.K2())
+        if err != nil {
+            return err
+        }
+        if err := k.refundOffer(ctx, offer, types.OfferStatus_OFFER_STATUS_REFUNDED); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+// ExpireOffers refunds the open offers whose expiry is at or before the current block time,
+// oldest first and at most Params.MaxExpiredListingsPerBlock per block. An offer whose refund
+// fails is logged and dropped from the queue; its buyer can still cancel it.
+func (k Keeper) ExpireOffers(ctx context.Context) error {
+    params, err := k.Params.Get(ctx)
+    if err != nil {
+        return err
+    }
+    return k.processDue(ctx, k.OffersByExpiry, int(params.MaxExpiredListingsPerBlock), "expire offer", func(ctx context.Context, id uint64) error {
+        offer, err := k.Offers.Get(ctx, id)
+        if err != nil {
+            return err
+        }
+        return k.refundOffer(ctx, offer, types.OfferStatus_OFFER_STATUS_EXPIRED)
+    })
+}
+
+// GetOffer returns an offer by ID and a boolean whether it exists.
+func (k Keeper) GetOffer(ctx context.Context, id uint64) (types.Offer, bool) {
+    offer, err := k.Offers.Get(ctx, id)
+    if err != nil {
+        return types.Offer{}, false
+    }
+    return offer, true
+}
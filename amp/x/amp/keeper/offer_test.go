@@ -0,0 +1,185 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"amp/x/amp/keeper"
+	"amp/x/amp/types"
+)
+
+func TestAcceptOfferRefundsOtherOffers(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	params := types.DefaultParams()
+	params.CommissionRate = sdkmath.LegacyNewDecWithPrec(1, 1)
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	seller := sdk.AccAddress("seller______________")
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 10)))
+	f.bankKeeper.fund(alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 500)))
+	f.bankKeeper.fund(bob, sdk.NewCoins(sdk.NewInt64Coin("stake", 500)))
+
+	listingID, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 400))})
+	require.NoError(t, err)
+
+	_, err = f.keeper.MakeOffer(ctx, alice, listingID, sdk.NewInt64Coin("token", 5), 0)
+	require.ErrorIs(t, err, types.ErrInvalidOffer)
+	_, err = f.keeper.MakeOffer(ctx, alice, listingID, sdk.NewInt64Coin("stake", 5), 1000)
+	require.ErrorIs(t, err, types.ErrInvalidExpiry)
+
+	aliceOffer, err := f.keeper.MakeOffer(ctx, alice, listingID, sdk.NewInt64Coin("stake", 300), 0)
+	require.NoError(t, err)
+	bobOffer, err := f.keeper.MakeOffer(ctx, bob, listingID, sdk.NewInt64Coin("stake", 200), 5000)
+	require.NoError(t, err)
+	require.Equal(t, int64(200), f.bankKeeper.balance(alice, "stake").Int64())
+	require.Equal(t, int64(300), f.bankKeeper.balance(bob, "stake").Int64())
+
+	require.ErrorIs(t, f.keeper.AcceptOffer(ctx, alice, aliceOffer), types.ErrUnauthorized)
+	require.NoError(t, f.keeper.AcceptOffer(ctx, seller, aliceOffer))
+
+	listing, found := f.keeper.GetListing(ctx, listingID)
+	require.True(t, found)
+	require.Equal(t, types.ListingStatus_LISTING_STATUS_SOLD, listing.Status)
+	require.Equal(t, alice.String(), listing.Buyer)
+	require.Equal(t, int64(10), f.bankKeeper.balance(alice, "token").Int64())
+	require.Equal(t, int64(270), f.bankKeeper.balance(seller, "stake").Int64())
+	require.Equal(t, int64(30), f.bankKeeper.balance(authtypes.NewModuleAddress(authtypes.FeeCollectorName), "stake").Int64())
+
+	// the competing offer is refunded and leaves the expiry index
+	offer, found := f.keeper.GetOffer(ctx, bobOffer)
+	require.True(t, found)
+	require.Equal(t, types.OfferStatus_OFFER_STATUS_REFUNDED, offer.Status)
+	require.Equal(t, int64(500), f.bankKeeper.balance(bob, "stake").Int64())
+	require.True(t, f.bankKeeper.balance(authtypes.NewModuleAddress(types.EscrowModuleName), "stake").IsZero())
+	require.ErrorIs(t, f.keeper.CancelOffer(ctx, bob, bobOffer), types.ErrOfferNotOpen)
+}
+
+func TestOfferCancelAndExpiry(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	seller := sdk.AccAddress("seller______________")
+	alice := sdk.AccAddress("alice_______________")
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 10)))
+	f.bankKeeper.fund(alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 500)))
+
+	listingID, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 400))})
+	require.NoError(t, err)
+
+	cancelled, err := f.keeper.MakeOffer(ctx, alice, listingID, sdk.NewInt64Coin("stake", 100), 0)
+	require.NoError(t, err)
+	expiring, err := f.keeper.MakeOffer(ctx, alice, listingID, sdk.NewInt64Coin("stake", 100), 1500)
+	require.NoError(t, err)
+	require.Equal(t, int64(300), f.bankKeeper.balance(alice, "stake").Int64())
+
+	require.ErrorIs(t, f.keeper.CancelOffer(ctx, seller, cancelled), types.ErrUnauthorized)
+	require.NoError(t, f.keeper.CancelOffer(ctx, alice, cancelled))
+	require.Equal(t, int64(400), f.bankKeeper.balance(alice, "stake").Int64())
+
+	ctx = ctx.WithBlockTime(time.Unix(1500, 0))
+	require.ErrorIs(t, f.keeper.AcceptOffer(ctx, seller, expiring), types.ErrOfferExpired)
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+
+	offer, found := f.keeper.GetOffer(ctx, expiring)
+	require.True(t, found)
+	require.Equal(t, types.OfferStatus_OFFER_STATUS_EXPIRED, offer.Status)
+	require.Equal(t, int64(500), f.bankKeeper.balance(alice, "stake").Int64())
+}
+
+func TestClosedOffersLeaveIndexesAndExpiryIsCapped(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.MaxExpiredListingsPerBlock = 2
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	seller := sdk.AccAddress("seller______________")
+	alice := sdk.AccAddress("alice_______________")
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 10)))
+	f.bankKeeper.fund(alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 500)))
+
+	listingID, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 400))})
+	require.NoError(t, err)
+
+	cancelled, err := f.keeper.MakeOffer(ctx, alice, listingID, sdk.NewInt64Coin("stake", 100), 0)
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		_, err := f.keeper.MakeOffer(ctx, alice, listingID, sdk.NewInt64Coin("stake", 100), 1500)
+		require.NoError(t, err)
+	}
+	require.NoError(t, f.keeper.CancelOffer(ctx, alice, cancelled))
+
+	openOffers := func() (byListing, byBuyer int) {
+		res, err := qs.OffersByListing(ctx, &types.QueryOffersByListingRequest{ListingId: listingID})
+		require.NoError(t, err)
+		mine, err := qs.OffersByBuyer(ctx, &types.QueryOffersByBuyerRequest{Buyer: alice.String()})
+		require.NoError(t, err)
+		return len(res.Offers), len(mine.Offers)
+	}
+	byListing, byBuyer := openOffers()
+	require.Equal(t, 3, byListing)
+	require.Equal(t, 3, byBuyer)
+
+	// only two offers expire per block
+	ctx = ctx.WithBlockTime(time.Unix(1500, 0))
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	byListing, byBuyer = openOffers()
+	require.Equal(t, 1, byListing)
+	require.Equal(t, 1, byBuyer)
+	require.Equal(t, int64(400), f.bankKeeper.balance(alice, "stake").Int64())
+
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	byListing, byBuyer = openOffers()
+	require.Zero(t, byListing)
+	require.Zero(t, byBuyer)
+	require.Equal(t, int64(500), f.bankKeeper.balance(alice, "stake").Int64())
+
+	// closed offers are still readable
+	offer, found := f.keeper.GetOffer(ctx, cancelled)
+	require.True(t, found)
+	require.Equal(t, types.OfferStatus_OFFER_STATUS_CANCELLED, offer.Status)
+}
+
+func TestOpenOffersPerListingAreCapped(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	params := types.DefaultParams()
+	params.MaxOpenOffersPerListing = 2
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	seller := sdk.AccAddress("seller______________")
+	alice := sdk.AccAddress("alice_______________")
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 20)))
+	f.bankKeeper.fund(alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 500)))
+
+	listingID, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 400))})
+	require.NoError(t, err)
+	otherID, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 400))})
+	require.NoError(t, err)
+
+	first, err := f.keeper.MakeOffer(ctx, alice, listingID, sdk.NewInt64Coin("stake", 1), 0)
+	require.NoError(t, err)
+	_, err = f.keeper.MakeOffer(ctx, alice, listingID, sdk.NewInt64Coin("stake", 1), 0)
+	require.NoError(t, err)
+	_, err = f.keeper.MakeOffer(ctx, alice, listingID, sdk.NewInt64Coin("stake", 1), 0)
+	require.ErrorIs(t, err, types.ErrOfferLimitReached)
+
+	// the cap is per listing, and a closed offer frees its slot
+	_, err = f.keeper.MakeOffer(ctx, alice, otherID, sdk.NewInt64Coin("stake", 1), 0)
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.CancelOffer(ctx, alice, first))
+	_, err = f.keeper.MakeOffer(ctx, alice, listingID, sdk.NewInt64Coin("stake", 1), 0)
+	require.NoError(t, err)
+}
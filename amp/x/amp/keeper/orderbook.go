@@ -0,0 +1,347 @@
+package keeper
+
+import (
+    "context"
+    "fmt"
+    "math"
+
+    "cosmossdk.io/collections"
+    sdkmath "cosmossdk.io/math"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+    authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+    "amp/x/amp/types"
+)
+
+// PlaceOrder escrows a new limit order, matches it against the best-priced resting orders
+// of the opposite side in price-time priority, and rests any remainder on the book. Each
+// match settles at the resting order's unit price with the same commission as BuyItem.
+// It returns the order ID and how much of quantity was filled immediately.
+func (k Keeper) PlaceOrder(ctx context.Context, owner sdk.AccAddress, side types.OrderSide, assetDenom string, unitPrice sdk.Coin, quantity sdkmath.Int) (uint64, sdkmath.Int, error) {
+    if err := types.ValidateOrder(side, assetDenom, unitPrice, quantity); err != nil {
+        return 0, sdkmath.Int{}, err
+    }
+
+    ownerStr, err := k.addressCodec.BytesToString(owner)
+    if err != nil {
+        return 0, sdkmath.Int{}, err
+    }
+    id, err := k.OrderSeq.Next(ctx)
+    if err != nil {
+        return 0, sdkmath.Int{}, err
+    }
+
+    sdkCtx := sdk.UnwrapSDKContext(ctx)
+    order := types.Order{
+        Id:         id,
+        Owner:      ownerStr,
+        Side:       side,
+        AssetDenom: assetDenom,
+        UnitPrice:  unitPrice,
+        Quantity:   quantity,
+        Remaining:  quantity,
+        Status:     types.OrderStatus_ORDER_STATUS_OPEN,
+        CreatedAt:  sdkCtx.BlockTime().Unix(),
+    }
+
+    locked, err := order.Escrowed(quantity)
+    if err != nil {
+        return 0, sdkmath.Int{}, err
+    }
+    escrow := authtypes.NewModuleAddress(types.EscrowModuleName)
+    if err := k.bankKeeper.SendCoins(ctx, owner, escrow, sdk.NewCoins(locked)); err != nil {
+        return 0, sdkmath.Int{}, err
+    }
+
+    _ = sdkCtx.EventManager().EmitTypedEvent(&types.EventOrderPlaced{
+        Id:         id,
+        Owner:      ownerStr,
+        Side:       side,
+        AssetDenom: assetDenom,
+        UnitPrice:  unitPrice,
+        Quantity:   quantity,
+    })
+    sdkCtx.EventManager().EmitEvent(
+        sdk.NewEvent(
+            types.EventTypeOrderPlaced,
+            sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprintf("%d", id)),
+            sdk.NewAttribute(types.AttributeKeyOwner, ownerStr),
+            sdk.NewAttribute(types.AttributeKeySide, side.String()),
+            sdk.NewAttribute(types.AttributeKeyAsset, assetDenom),
+            sdk.NewAttribute(types.AttributeKeyUnitPrice, unitPrice.String()),
+            sdk.NewAttribute(types.AttributeKeyQuantity, quantity.String()),
+        ),
+    )
+
+    if err := k.matchOrder(ctx, &order); err != nil {
+        return 0, sdkmath.Int{}, err
+    }
+
+    if order.Remaining.IsZero() {
+        order.Status = types.OrderStatus_ORDER_STATUS_FILLED
+    } else if err := k.orderBook(side).Set(ctx, bookKey(order)); err != nil {
+        return 0, sdkmath.Int{}, err
+    }
+    if err := k.Orders.Set(ctx, id, order); err != nil {
+        return 0, sdkmath.Int{}, err
+    }
+    return id, quantity.Sub(order.Remaining), nil
+}
+
+// CancelOrder removes an open order from the book, only by its owner, and refunds what it
+// still holds in escrow.
+func (k Keeper) CancelOrder(ctx context.Context, owner sdk.AccAddress, id uint64) error {
+    order, err := k.Orders.Get(ctx, id)
+    if err != nil {
+        return err
+    }
+    if order.Status != types.OrderStatus_ORDER_STATUS_OPEN {
+        return types.ErrOrderNotOpen
+    }
+
+    ownerStr, _ := k.addressCodec.BytesToString(owner)
+    if ownerStr != order.Owner {
+        return types.ErrUnauthorized
+    }
+
+    refund, err := order.Escrowed(order.Remaining)
+    if err != nil {
+        return err
+    }
+    escrow := authtypes.NewModuleAddress(types.EscrowModuleName)
+    if err := k.bankKeeper.SendCoins(ctx, escrow, owner, sdk.NewCoins(refund)); err != nil {
+        return err
+    }
+
+    order.Status = types.OrderStatus_ORDER_STATUS_CANCELLED
+    if err := k.Orders.Set(ctx, id, order); err != nil {
+        return err
+    }
+    if err := k.orderBook(order.Side).Remove(ctx, bookKey(order)); err != nil {
+        return err
+    }
+
+    sdkCtx := sdk.UnwrapSDKContext(ctx)
+    _ = sdkCtx.EventManager().EmitTypedEvent(&types.EventOrderCancelled{
+        Id:     id,
+        Owner:  ownerStr,
+        Refund: refund,
+    })
+    sdkCtx.EventManager().EmitEvent(
+        sdk.NewEvent(
+            types.EventTypeOrderCancelled,
+            sdk.NewAttribute(types.AttributeKeyOrderID, fmt.Sprintf("%d", id)),
+            sdk.NewAttribute(types.AttributeKeyOwner, ownerStr),
+            sdk.NewAttribute(types.AttributeKeyAmount, refund.String()),
+        ),
+    )
+    return nil
+}
+
+// matchOrder fills taker against the crossing orders of the opposite side, best price
+// first and oldest first within a price, until taker is filled or the book stops crossing.
+// Orders of the taker's own owner are skipped.
+func (k Keeper) matchOrder(ctx context.Context, taker *types.Order) error {
+    makers, err := k.crossingOrders(ctx, *taker)
+    if err != nil {
+        return err
+    }
+    for _, maker := range makers {
+        if taker.Remaining.IsZero() {
+            break
+        }
+        if err := k.fillOrders(ctx, maker, taker, sdkmath.MinInt(taker.Remaining, maker.Remaining)); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+// crossingOrders returns, in priority order, the resting orders taker can fill against,
+// up to as many as it takes to fill it.
+func (k Keeper) crossingOrders(ctx context.Context, taker types.Order) ([]types.Order, error) {
+    side := taker.Side.Opposite()
+    it, err := k.orderBook(side).Iterate(ctx, collections.NewPrefixedTripleRange[string, uint64, uint64](taker.Book()))
+    if err != nil {
+        return nil, err
+    }
+    defer it.Close()
+
+    var makers []types.Order
+    needed := taker.Remaining
+    for ; it.Valid() && needed.IsPositive(); it.Next() {
+        key, err := it.Key()
+        if err != nil {
+            return nil, err
+        }
+        if !taker.Crosses(bookPrice(side, key)) {
+            break
+        }
+        maker, err := k.Orders.Get(ctx, key.K3())
+        if err != nil {
+            return nil, err
+        }
+        if maker.Owner == taker.Owner {
+            continue
+        }
+        makers = append(makers, maker)
+        needed = needed.Sub(sdkmath.MinInt(needed, maker.Remaining))
+    }
+    return makers, nil
+}
+
+// fillOrders trades quantity between a resting maker and the incoming taker at the
+// maker's unit price. The seller is paid from the buy order's escrow through
+// settlePayment, less the royalties of the asset denom, and the buyer receives the asset from the sell order's escrow; a taking
+// buyer gets back what it escrowed above the maker's price.
+func (k Keeper) fillOrders(ctx context.Context, maker types.Order, taker *types.Order, quantity sdkmath.Int) error {
+    buyOrder, sellOrder := maker, *taker
+    if taker.Side == types.OrderSide_ORDER_SIDE_BUY {
+        buyOrder, sellOrder = *taker, maker
+    }
+    buyer, err := k.addressCodec.StringToBytes(buyOrder.Owner)
+    if err != nil {
+        return err
+    }
+    seller, err := k.addressCodec.StringToBytes(sellOrder.Owner)
+    if err != nil {
+        return err
+    }
+
+    escrow := authtypes.NewModuleAddress(types.EscrowModuleName)
+    price := sdk.NewCoin(maker.UnitPrice.Denom, maker.UnitPrice.Amount.Mul(quantity))
+    asset := sdk.NewCoin(maker.AssetDenom, quantity)
+    royalties, err := k.saleRoyalties(ctx, price, sdk.NewCoins(asset), nil)
+    if err != nil {
+        return err
+    }
+    fee, _, _, err := k.settlePayment(ctx, escrow, seller, price, royalties, nil)
+    if err != nil {
+        return err
+    }
+    if err := k.releaseEscrow(ctx, buyer, sdk.NewCoins(asset), nil); err != nil {
+        return err
+    }
+    if err := k.recordSale(ctx, price, fee, sdk.NewCoins(asset), nil); err != nil {
+        return err
+    }
+    if taker.Side == types.OrderSide_ORDER_SIDE_BUY {
+        improvement := taker.UnitPrice.Amount.Sub(maker.UnitPrice.Amount).Mul(quantity)
+        if improvement.IsPositive() {
+            if err := k.bankKeeper.SendCoins(ctx, escrow, buyer, sdk.NewCoins(sdk.NewCoin(price.Denom, improvement))); err != nil {
+                return err
+            }
+        }
+    }
+
+    taker.Remaining = taker.Remaining.Sub(quantity)
+    maker.Remaining = maker.Remaining.Sub(quantity)
+    if maker.Remaining.IsZero() {
+        maker.Status = types.OrderStatus_ORDER_STATUS_FILLED
+        if err := k.orderBook(maker.Side).Remove(ctx, bookKey(maker)); err != nil {
+            return err
+        }
+    }
+    if err := k.Orders.Set(ctx, maker.Id, maker); err != nil {
+        return err
+    }
+
+    sdkCtx := sdk.UnwrapSDKContext(ctx)
+    _ = sdkCtx.EventManager().EmitTypedEvent(&types.EventOrderFilled{
+        MakerOrderId: maker.Id,
+        TakerOrderId: taker.Id,
+        Buyer:        buyOrder.Owner,
+        Seller:       sellOrder.Owner,
+        Asset:        asset,
+        Price:        price,
+        Fee:          fee,
+        Royalties:    royalties,
+    })
+    sdkCtx.EventManager().EmitEvent(
+        sdk.NewEvent(
+            types.EventTypeOrderFilled,
+            sdk.NewAttribute(types.AttributeKeyMakerOrderID, fmt.Sprintf("%d", maker.Id)),
+            sdk.NewAttribute(types.AttributeKeyTakerOrderID, fmt.Sprintf("%d", taker.Id)),
+            sdk.NewAttribute(types.AttributeKeyBuyer, buyOrder.Owner),
+            sdk.NewAttribute(types.AttributeKeySeller, sellOrder.Owner),
+            sdk.NewAttribute(types.AttributeKeyAsset, asset.String()),
+            sdk.NewAttribute(types.AttributeKeyPrice, price.String()),
+            sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+            sdk.NewAttribute(types.AttributeKeyRoyalty, royaltyTotal(royalties).String()),
+        ),
+    )
+    return nil
+}
+
+// PriceLevels aggregates the open orders on side of the book trading assetDenom for
+// priceDenom into at most limit price levels, best price first.
+func (k Keeper) PriceLevels(ctx context.Context, side types.OrderSide, assetDenom, priceDenom string, limit uint32) ([]types.PriceLevel, error) {
+    it, err := k.orderBook(side).Iterate(ctx, collections.NewPrefixedTripleRange[string, uint64, uint64](types.OrderBook(assetDenom, priceDenom)))
+    if err != nil {
+        return nil, err
+    }
+    defer it.Close()
+
+    var levels []types.PriceLevel
+    for ; it.Valid(); it.Next() {
+        key, err := it.Key()
+        if err != nil {
+            return nil, err
+        }
+        order, err := k.Orders.Get(ctx, key.K3())
+        if err != nil {
+            return nil, err
+        }
+        price := bookPrice(side, key)
+        if n := len(levels); n > 0 && levels[n-1].UnitPrice.Amount.Equal(price) {
+            levels[n-1].Quantity = levels[n-1].Quantity.Add(order.Remaining)
+            levels[n-1].Orders++
+            continue
+        }
+        if uint32(len(levels)) == limit {
+            break
+        }
+        levels = append(levels, types.PriceLevel{
+            UnitPrice: sdk.NewCoin(priceDenom, price),
+            Quantity:  order.Remaining,
+            Orders:    1,
+        })
+    }
+    return levels, nil
+}
+
+// GetOrder returns a limit order by ID and a boolean whether it exists.
+func (k Keeper) GetOrder(ctx context.Context, id uint64) (types.Order, bool) {
+    order, err := k.Orders.Get(ctx, id)
+    if err != nil {
+        return types.Order{}, false
+    }
+    return order, true
+}
+
+// orderBook returns the index of the open orders on side.
+func (k Keeper) orderBook(side types.OrderSide) collections.KeySet[collections.Triple[string, uint64, uint64]] {
+    if side == types.OrderSide_ORDER_SIDE_BUY {
+        return k.OrderBookBids
+    }
+    return k.OrderBookAsks
+}
+
+// bookKey returns the key of an open order in its side's index. Bid prices are inverted
+// so that both sides iterate best price first, and oldest first within a price.
+func bookKey(order types.Order) collections.Triple[string, uint64, uint64] {
+    price := order.UnitPrice.Amount.Uint64()
+    if order.Side == types.OrderSide_ORDER_SIDE_BUY {
+        price = math.MaxUint64 - price
+    }
+    return collections.Join3(order.Book(), price, order.Id)
+}
+
+// bookPrice recovers the unit price from a key of side's index.
+func bookPrice(side types.OrderSide, key collections.Triple[string, uint64, uint64]) sdkmath.Int {
+    price := key.K2()
+    if side == types.OrderSide_ORDER_SIDE_BUY {
+        price = math.MaxUint64 - price
+    }
+    return sdkmath.NewIntFromUint64(price)
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"amp/x/amp/keeper"
+	"amp/x/amp/types"
+)
+
+func TestOrderBookMatching(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.CommissionRate = sdkmath.LegacyNewDecWithPrec(1, 1)
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	carol := sdk.AccAddress("carol_______________")
+	dave := sdk.AccAddress("dave________________")
+	buyer := sdk.AccAddress("buyer_______________")
+	for _, addr := range []sdk.AccAddress{alice, bob, carol, dave} {
+		f.bankKeeper.fund(addr, sdk.NewCoins(sdk.NewInt64Coin("token", 10)))
+	}
+	f.bankKeeper.fund(buyer, sdk.NewCoins(sdk.NewInt64Coin("stake", 200)))
+
+	sell, buy := types.OrderSide_ORDER_SIDE_SELL, types.OrderSide_ORDER_SIDE_BUY
+	place := func(owner sdk.AccAddress, side types.OrderSide, price, quantity int64) (uint64, sdkmath.Int) {
+		id, filled, err := f.keeper.PlaceOrder(ctx, owner, side, "token", sdk.NewInt64Coin("stake", price), sdkmath.NewInt(quantity))
+		require.NoError(t, err)
+		return id, filled
+	}
+
+	_, _, err := f.keeper.PlaceOrder(ctx, alice, sell, "stake", sdk.NewInt64Coin("stake", 5), sdkmath.NewInt(1))
+	require.ErrorIs(t, err, types.ErrInvalidOrder)
+	_, _, err = f.keeper.PlaceOrder(ctx, alice, types.OrderSide_ORDER_SIDE_UNSPECIFIED, "token", sdk.NewInt64Coin("stake", 5), sdkmath.NewInt(1))
+	require.ErrorIs(t, err, types.ErrInvalidOrder)
+
+	aliceAsk, _ := place(alice, sell, 5, 10)
+	place(bob, sell, 4, 10)
+	carolAsk, _ := place(carol, sell, 5, 5)
+
+	depth, err := qs.OrderBookDepth(ctx, &types.QueryOrderBookDepthRequest{AssetDenom: "token", PriceDenom: "stake"})
+	require.NoError(t, err)
+	require.Empty(t, depth.Bids)
+	require.Equal(t, []types.PriceLevel{
+		{UnitPrice: sdk.NewInt64Coin("stake", 4), Quantity: sdkmath.NewInt(10), Orders: 1},
+		{UnitPrice: sdk.NewInt64Coin("stake", 5), Quantity: sdkmath.NewInt(15), Orders: 2},
+	}, depth.Asks)
+
+	// the buy sweeps the cheaper ask first, then the older of the two asks at 5
+	_, filled := place(buyer, buy, 5, 22)
+	require.Equal(t, int64(22), filled.Int64())
+	require.Equal(t, int64(22), f.bankKeeper.balance(buyer, "token").Int64())
+	require.Equal(t, int64(100), f.bankKeeper.balance(buyer, "stake").Int64())
+	require.Equal(t, int64(36), f.bankKeeper.balance(bob, "stake").Int64())
+	require.Equal(t, int64(45), f.bankKeeper.balance(alice, "stake").Int64())
+	require.Equal(t, int64(9), f.bankKeeper.balance(carol, "stake").Int64())
+	require.Equal(t, int64(10), f.bankKeeper.balance(authtypes.NewModuleAddress(authtypes.FeeCollectorName), "stake").Int64())
+
+	order, _ := f.keeper.GetOrder(ctx, aliceAsk)
+	require.Equal(t, types.OrderStatus_ORDER_STATUS_FILLED, order.Status)
+	order, _ = f.keeper.GetOrder(ctx, carolAsk)
+	require.Equal(t, types.OrderStatus_ORDER_STATUS_OPEN, order.Status)
+	require.Equal(t, int64(3), order.Remaining.Int64())
+
+	// a bid below the best ask rests on the book
+	bid, filled := place(buyer, buy, 3, 10)
+	require.True(t, filled.IsZero())
+	require.Equal(t, int64(70), f.bankKeeper.balance(buyer, "stake").Int64())
+
+	best, err := qs.BestBidAsk(ctx, &types.QueryBestBidAskRequest{AssetDenom: "token", PriceDenom: "stake"})
+	require.NoError(t, err)
+	require.Equal(t, &types.PriceLevel{UnitPrice: sdk.NewInt64Coin("stake", 3), Quantity: sdkmath.NewInt(10), Orders: 1}, best.BestBid)
+	require.Equal(t, &types.PriceLevel{UnitPrice: sdk.NewInt64Coin("stake", 5), Quantity: sdkmath.NewInt(3), Orders: 1}, best.BestAsk)
+
+	// an incoming sell fills at the resting bid's price
+	_, filled = place(dave, sell, 2, 4)
+	require.Equal(t, int64(4), filled.Int64())
+	require.Equal(t, int64(10), f.bankKeeper.balance(dave, "stake").Int64())
+	require.Equal(t, int64(26), f.bankKeeper.balance(buyer, "token").Int64())
+
+	require.ErrorIs(t, f.keeper.CancelOrder(ctx, dave, bid), types.ErrUnauthorized)
+	require.NoError(t, f.keeper.CancelOrder(ctx, buyer, bid))
+	require.ErrorIs(t, f.keeper.CancelOrder(ctx, buyer, bid), types.ErrOrderNotOpen)
+	require.Equal(t, int64(88), f.bankKeeper.balance(buyer, "stake").Int64())
+
+	best, err = qs.BestBidAsk(ctx, &types.QueryBestBidAskRequest{AssetDenom: "token", PriceDenom: "stake"})
+	require.NoError(t, err)
+	require.Nil(t, best.BestBid)
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+    "context"
+
+    "cosmossdk.io/collections"
+    sdkmath "cosmossdk.io/math"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+
+    "amp/x/amp/types"
+)
+
+// PriceIndexKey is a key of the listing price index: (asset denom, price denom, unit
+// price, listing id). Listings that have unit prices (see Listing.UnitPrices) are indexed
+// once per price option, cheapest first within an asset and price denom.
+type PriceIndexKey = collections.Quad[string, string, sdkmath.LegacyDec, uint64]
+
+// priceIndexKeys returns the keys a listing has in the price index.
+func priceIndexKeys(listing types.Listing) []PriceIndexKey {
+    assetDenom, prices, ok := listing.UnitPrices()
+    if !ok {
+        return nil
+    }
+    keys := make([]PriceIndexKey, 0, len(prices))
+    for _, price := range prices {
+        keys = append(keys, collections.Join4(assetDenom, price.Denom, price.Amount, listing.Id))
+    }
+    return keys
+}
+
+// FloorPrice returns the cheapest unit price of assetDenom among open listings in
+// priceDenom, or nil if there is none. Listings past their expiry that EndBlock has not
+// closed yet are skipped.
+func (k Keeper) FloorPrice(ctx context.Context, assetDenom, priceDenom string) (*types.FloorPrice, error) {
+    it, err := k.Listings.Indexes.Price.Iterate(ctx, collections.NewSuperPrefixedQuadRange[string, string, sdkmath.LegacyDec, uint64](assetDenom, priceDenom))
+    if err != nil {
+        return nil, err
+    }
+    defer it.Close()
+
+    for ; it.Valid(); it.Next() {
+        key, err := it.Key()
+        if err != nil {
+            return nil, err
+        }
+        listing, err := k.Listings.Get(ctx, key.K4())
+        if err != nil {
+            return nil, err
+        }
+        if k.isExpired(ctx, listing) {
+            continue
+        }
+        return &types.FloorPrice{
+            UnitPrice: sdk.NewDecCoinFromDec(priceDenom, key.K3()),
+            ListingId: listing.Id,
+        }, nil
+    }
+    return nil, nil
+}
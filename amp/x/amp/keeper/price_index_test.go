@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"amp/x/amp/keeper"
+	"amp/x/amp/types"
+)
+
+func TestListingPriceIndex(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	seller := sdk.AccAddress("seller______________")
+	buyer := sdk.AccAddress("buyer_______________")
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 30), sdk.NewInt64Coin("gem", 1)))
+	f.bankKeeper.fund(buyer, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+
+	list := func(asset, price sdk.Coins, partialFills bool) uint64 {
+		id, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: asset, Price: price, PartialFills: partialFills})
+		require.NoError(t, err)
+		return id
+	}
+	whole := list(sdk.NewCoins(sdk.NewInt64Coin("token", 10)), sdk.NewCoins(sdk.NewInt64Coin("stake", 15)), false)
+	perUnit := list(sdk.NewCoins(sdk.NewInt64Coin("token", 10)), sdk.NewCoins(sdk.NewInt64Coin("stake", 1), sdk.NewInt64Coin("uatom", 3)), true)
+	dear := list(sdk.NewCoins(sdk.NewInt64Coin("token", 4)), sdk.NewCoins(sdk.NewInt64Coin("stake", 8)), false)
+	// bundles have no unit price
+	list(sdk.NewCoins(sdk.NewInt64Coin("token", 1), sdk.NewInt64Coin("gem", 1)), sdk.NewCoins(sdk.NewInt64Coin("stake", 1)), false)
+
+	floor := func(priceDenom string) *types.FloorPrice {
+		res, err := qs.FloorPrice(ctx, &types.QueryFloorPriceRequest{AssetDenom: "token", PriceDenom: priceDenom})
+		require.NoError(t, err)
+		return res.Floor
+	}
+	unitPrice := func(amount string) sdk.DecCoin {
+		return sdk.NewDecCoinFromDec("stake", sdkmath.LegacyMustNewDecFromStr(amount))
+	}
+
+	res, err := qs.ListingsByPrice(ctx, &types.QueryListingsByPriceRequest{AssetDenom: "token", PriceDenom: "stake", Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, res.Listings, 2)
+	require.Equal(t, perUnit, res.Listings[0].Listing.Id)
+	require.Equal(t, unitPrice("1"), res.Listings[0].UnitPrice)
+	require.Equal(t, whole, res.Listings[1].Listing.Id)
+	require.Equal(t, unitPrice("1.5"), res.Listings[1].UnitPrice)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+	res, err = qs.ListingsByPrice(ctx, &types.QueryListingsByPriceRequest{AssetDenom: "token", PriceDenom: "stake", Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, res.Listings, 1)
+	require.Equal(t, dear, res.Listings[0].Listing.Id)
+	require.Equal(t, unitPrice("2"), res.Listings[0].UnitPrice)
+
+	// most expensive first
+	res, err = qs.ListingsByPrice(ctx, &types.QueryListingsByPriceRequest{AssetDenom: "token", PriceDenom: "stake", Pagination: &query.PageRequest{Limit: 1, Reverse: true}})
+	require.NoError(t, err)
+	require.Equal(t, dear, res.Listings[0].Listing.Id)
+	_, err = qs.ListingsByPrice(ctx, &types.QueryListingsByPriceRequest{AssetDenom: "token", PriceDenom: "uatom", Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = qs.ListingsByPrice(ctx, &types.QueryListingsByPriceRequest{AssetDenom: "token", PriceDenom: "stake", Pagination: &query.PageRequest{Key: res.Pagination.NextKey[:len(res.Pagination.NextKey)-1]}})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	require.Equal(t, &types.FloorPrice{UnitPrice: unitPrice("1"), ListingId: perUnit}, floor("stake"))
+	require.Equal(t, perUnit, floor("uatom").ListingId)
+
+	// a partial fill keeps the unit price, selling out removes the listing
+	require.NoError(t, f.keeper.BuyItem(ctx, buyer, perUnit, types.BuyOptions{Quantity: sdkmath.NewInt(5), Denom: "stake"}))
+	require.Equal(t, perUnit, floor("stake").ListingId)
+	require.NoError(t, f.keeper.BuyItem(ctx, buyer, perUnit, types.BuyOptions{Quantity: sdkmath.NewInt(5), Denom: "stake"}))
+	require.Equal(t, &types.FloorPrice{UnitPrice: unitPrice("1.5"), ListingId: whole}, floor("stake"))
+	require.Nil(t, floor("uatom"))
+
+	// a price update moves the listing, delisting removes it
+	_, err = f.keeper.UpdateListing(ctx, seller, whole, "", "", sdk.NewCoins(sdk.NewInt64Coin("stake", 5)), nil)
+	require.NoError(t, err)
+	require.Equal(t, unitPrice("0.5"), floor("stake").UnitPrice)
+	require.NoError(t, f.keeper.DelistItem(ctx, seller, whole))
+	require.Equal(t, &types.FloorPrice{UnitPrice: unitPrice("2"), ListingId: dear}, floor("stake"))
+
+	_, err = qs.FloorPrice(ctx, &types.QueryFloorPriceRequest{AssetDenom: "token"})
+	require.Error(t, err)
+}
+
+func TestListingsByPriceSkipsExpiredListings(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	seller := sdk.AccAddress("seller______________")
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 20)))
+	list := func(price, expiresAt int64) uint64 {
+		id, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", price)), ExpiresAt: expiresAt})
+		require.NoError(t, err)
+		return id
+	}
+	cheap := list(10, 1500)
+	dear := list(20, 0)
+
+	byPrice := func(ctx sdk.Context) *types.QueryListingsByPriceResponse {
+		res, err := qs.ListingsByPrice(ctx, &types.QueryListingsByPriceRequest{AssetDenom: "token", PriceDenom: "stake"})
+		require.NoError(t, err)
+		return res
+	}
+	res := byPrice(ctx)
+	require.Len(t, res.Listings, 2)
+	require.Equal(t, cheap, res.Listings[0].Listing.Id)
+
+	// past its expiry, before EndBlock closes it, the cheap listing is left out of both queries
+	expired := ctx.WithBlockTime(time.Unix(1500, 0))
+	res = byPrice(expired)
+	require.Len(t, res.Listings, 1)
+	require.Equal(t, dear, res.Listings[0].Listing.Id)
+	require.Equal(t, uint64(1), res.Pagination.Total)
+	floor, err := qs.FloorPrice(expired, &types.QueryFloorPriceRequest{AssetDenom: "token", PriceDenom: "stake"})
+	require.NoError(t, err)
+	require.Equal(t, dear, floor.Floor.ListingId)
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+    "context"
+    "errors"
+
+    "cosmossdk.io/collections"
+    "github.com/cosmos/cosmos-sdk/types/query"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+
+    "amp/x/amp/types"
+)
+
+func (q queryServer) Auction(ctx context.Context, req *types.QueryAuctionRequest) (*types.QueryAuctionResponse, error) {
+    if req == nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+
+    auction, err := q.k.Auctions.Get(ctx, req.Id)
+    if err != nil {
+        if errors.Is(err, collections.ErrNotFound) {
+            return nil, status.Error(codes.NotFound, "auction not found")
+        }
+        return nil, status.Error(codes.Internal, "internal error")
+    }
+    return &types.QueryAuctionResponse{Auction: &auction}, nil
+}
+
+func (q queryServer) Auctions(ctx context.Context, req *types.QueryAuctionsRequest) (*types.QueryAuctionsResponse, error) {
+    if req == nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+
+    if err := checkPageRequest(req.Pagination); err != nil {
+        return nil, err
+    }
+
+    auctions, pageRes, err := query.CollectionPaginate(
+        ctx,
+        q.k.Auctions,
+        req.Pagination,
+        func(_ uint64, auction types.Auction) (*types.Auction, error) {
+            return &auction, nil
+        },
+    )
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    return &types.QueryAuctionsResponse{Auctions: auctions, Pagination: pageRes}, nil
+}
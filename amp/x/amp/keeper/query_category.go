@@ -0,0 +1,82 @@
+package keeper
+
+import (
+    "context"
+
+    "cosmossdk.io/collections"
+    "github.com/cosmos/cosmos-sdk/types/query"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+
+    "amp/x/amp/types"
+)
+
+func (q queryServer) Categories(ctx context.Context, req *types.QueryCategoriesRequest) (*types.QueryCategoriesResponse, error) {
+    if req == nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+
+    if err := checkPageRequest(req.Pagination); err != nil {
+        return nil, err
+    }
+
+    categories, pageRes, err := query.CollectionPaginate(
+        ctx,
+        q.k.Categories,
+        req.Pagination,
+        func(category string, _ collections.NoValue) (string, error) {
+            return category, nil
+        },
+    )
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    return &types.QueryCategoriesResponse{Categories: categories, Pagination: pageRes}, nil
+}
+
+func (q queryServer) ListingsByCategory(ctx context.Context, req *types.QueryListingsByCategoryRequest) (*types.QueryListingsByCategoryResponse, error) {
+    if req == nil || req.Category == "" {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+
+    listings, pageRes, err := q.paginateTaxonomy(ctx, q.k.Listings.Indexes.Category, req.Category, req.Pagination)
+    if err != nil {
+        return nil, paginationError(err)
+    }
+
+    return &types.QueryListingsByCategoryResponse{Listings: listings, Pagination: pageRes}, nil
+}
+
+func (q queryServer) ListingsByTag(ctx context.Context, req *types.QueryListingsByTagRequest) (*types.QueryListingsByTagResponse, error) {
+    if req == nil || req.Tag == "" {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+
+    listings, pageRes, err := q.paginateTaxonomy(ctx, q.k.Listings.Indexes.Tags, req.Tag, req.Pagination)
+    if err != nil {
+        return nil, paginationError(err)
+    }
+
+    return &types.QueryListingsByTagResponse{Listings: listings, Pagination: pageRes}, nil
+}
+
+// paginateTaxonomy pages through the open listings an index holds under a category or tag.
+func (q queryServer) paginateTaxonomy(ctx context.Context, index *ListingKeysIndex[collections.Pair[string, uint64]], name string, pageReq *query.PageRequest) ([]*types.Listing, *query.PageResponse, error) {
+    if err := checkPageRequest(pageReq); err != nil {
+        return nil, nil, err
+    }
+    return query.CollectionPaginate(
+        ctx,
+        index,
+        pageReq,
+        func(key collections.Pair[string, uint64], _ collections.NoValue) (*types.Listing, error) {
+            listing, err := q.k.Listings.Get(ctx, key.K2())
+            if err != nil {
+                return nil, err
+            }
+            return &listing, nil
+        },
+        query.WithCollectionPaginationPairPrefix[string, uint64](name),
+    )
+}
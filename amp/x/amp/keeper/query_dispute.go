@@ -0,0 +1,52 @@
+package keeper
+
+import (
+    "context"
+    "errors"
+
+    "cosmossdk.io/collections"
+    "github.com/cosmos/cosmos-sdk/types/query"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+
+    "amp/x/amp/types"
+)
+
+func (q queryServer) Dispute(ctx context.Context, req *types.QueryDisputeRequest) (*types.QueryDisputeResponse, error) {
+    if req == nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+
+    dispute, err := q.k.Disputes.Get(ctx, req.ListingId)
+    if err != nil {
+        if errors.Is(err, collections.ErrNotFound) {
+            return nil, status.Error(codes.NotFound, "dispute not found")
+        }
+        return nil, status.Error(codes.Internal, "internal error")
+    }
+    return &types.QueryDisputeResponse{Dispute: &dispute}, nil
+}
+
+func (q queryServer) Arbiters(ctx context.Context, req *types.QueryArbitersRequest) (*types.QueryArbitersResponse, error) {
+    if req == nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+
+    if err := checkPageRequest(req.Pagination); err != nil {
+        return nil, err
+    }
+
+    arbiters, pageRes, err := query.CollectionPaginate(
+        ctx,
+        q.k.Arbiters,
+        req.Pagination,
+        func(arbiter string, _ collections.NoValue) (string, error) {
+            return arbiter, nil
+        },
+    )
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    return &types.QueryArbitersResponse{Arbiters: arbiters, Pagination: pageRes}, nil
+}
@@ -1,10 +1,16 @@
 package keeper
 
 import (
+    "bytes"
     "context"
     "errors"
 
     "cosmossdk.io/collections"
+    collcodec "cosmossdk.io/collections/codec"
+    "cosmossdk.io/collections/indexes"
+    sdkmath "cosmossdk.io/math"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+    "github.com/cosmos/cosmos-sdk/types/query"
     "google.golang.org/grpc/codes"
     "google.golang.org/grpc/status"
 
@@ -25,25 +31,398 @@ func (q queryServer) Listing(ctx context.Context, req *types.QueryListingRequest
     return &types.QueryListingResponse{Listing: &listing}, nil
 }
 
-func (q queryServer) Listings(ctx context.Context, req *types.QueryListingsRequest) (*types.QueryListingsResponse, error) {
+func (q queryServer) ListingPrice(ctx context.Context, req *types.QueryListingPriceRequest) (*types.QueryListingPriceResponse, error) {
     if req == nil {
         return nil, status.Error(codes.InvalidArgument, "invalid request")
     }
-    var listings []*types.Listing
-    rng := collections.Range[uint64]{}
-    it, err := q.k.Listings.Iterate(ctx, &rng)
+
+    listing, err := q.k.Listings.Get(ctx, req.Id)
     if err != nil {
+        if errors.Is(err, collections.ErrNotFound) {
+            return nil, status.Error(codes.NotFound, "listing not found")
+        }
         return nil, status.Error(codes.Internal, "internal error")
     }
-    defer it.Close()
-    for ; it.Valid(); it.Next() {
-        v, err := it.Value()
+    return &types.QueryListingPriceResponse{Price: q.k.CurrentPrice(ctx, listing)}, nil
+}
+
+func (q queryServer) BuyQuote(ctx context.Context, req *types.QueryBuyQuoteRequest) (*types.QueryBuyQuoteResponse, error) {
+    if req == nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+    buyer, err := q.k.addressCodec.StringToBytes(req.Buyer)
+    if err != nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid buyer address")
+    }
+
+    quote, err := q.k.QuoteBuy(ctx, buyer, req.Id, req.Quantity, req.Denom)
+    if err != nil {
+        if errors.Is(err, collections.ErrNotFound) {
+            return nil, status.Error(codes.NotFound, "listing not found")
+        }
+        return nil, status.Error(codes.InvalidArgument, err.Error())
+    }
+    return &types.QueryBuyQuoteResponse{Quote: quote}, nil
+}
+
+func (q queryServer) ListingPriceHistory(ctx context.Context, req *types.QueryListingPriceHistoryRequest) (*types.QueryListingPriceHistoryResponse, error) {
+    if req == nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+
+    if err := checkPageRequest(req.Pagination); err != nil {
+        return nil, err
+    }
+
+    changes, pageRes, err := query.CollectionPaginate(
+        ctx,
+        q.k.PriceHistory,
+        req.Pagination,
+        func(_ collections.Pair[uint64, uint64], change types.PriceChange) (types.PriceChange, error) {
+            return change, nil
+        },
+        query.WithCollectionPaginationPairPrefix[uint64, uint64](req.Id),
+    )
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    return &types.QueryListingPriceHistoryResponse{Changes: changes, Pagination: pageRes}, nil
+}
+
+func (q queryServer) Listings(ctx context.Context, req *types.QueryListingsRequest) (*types.QueryListingsResponse, error) {
+    if req == nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+
+    // listings are keyed by id, so reverse pages start from the newest
+    if err := checkPageRequest(req.Pagination); err != nil {
+        return nil, err
+    }
+
+    listings, pageRes, err := query.CollectionPaginate(
+        ctx,
+        q.k.Listings,
+        req.Pagination,
+        func(_ uint64, listing types.Listing) (*types.Listing, error) {
+            return &listing, nil
+        },
+    )
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    return &types.QueryListingsResponse{Listings: listings, Pagination: pageRes}, nil
+}
+
+func (q queryServer) ListingsByNFTClass(ctx context.Context, req *types.QueryListingsByNFTClassRequest) (*types.QueryListingsByNFTClassResponse, error) {
+    if req == nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+
+    if err := checkPageRequest(req.Pagination); err != nil {
+        return nil, err
+    }
+
+    listings, pageRes, err := query.CollectionPaginate(
+        ctx,
+        q.k.ListingsByNFTClass,
+        req.Pagination,
+        func(key collections.Pair[string, uint64], _ collections.NoValue) (*types.Listing, error) {
+            listing, err := q.k.Listings.Get(ctx, key.K2())
+            if err != nil {
+                return nil, err
+            }
+            return &listing, nil
+        },
+        query.WithCollectionPaginationPairPrefix[string, uint64](req.ClassId),
+    )
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    return &types.QueryListingsByNFTClassResponse{Listings: listings, Pagination: pageRes}, nil
+}
+
+func (q queryServer) ListingsBySeller(ctx context.Context, req *types.QueryListingsBySellerRequest) (*types.QueryListingsBySellerResponse, error) {
+    if req == nil || req.Seller == "" {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+
+    listings, pageRes, err := paginateListingIndex(ctx, q.k, q.k.Listings.Indexes.Seller, req.Seller, req.Pagination)
+    if err != nil {
+        return nil, paginationError(err)
+    }
+
+    return &types.QueryListingsBySellerResponse{Listings: listings, Pagination: pageRes}, nil
+}
+
+func (q queryServer) ListingsByBuyer(ctx context.Context, req *types.QueryListingsByBuyerRequest) (*types.QueryListingsByBuyerResponse, error) {
+    if req == nil || req.Buyer == "" {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+
+    if err := checkPageRequest(req.Pagination); err != nil {
+        return nil, err
+    }
+
+    listings, pageRes, err := query.CollectionPaginate(
+        ctx,
+        q.k.ListingsByBuyer,
+        req.Pagination,
+        func(key collections.Pair[string, uint64], _ collections.NoValue) (*types.Listing, error) {
+            listing, err := q.k.Listings.Get(ctx, key.K2())
+            if err != nil {
+                return nil, err
+            }
+            return &listing, nil
+        },
+        query.WithCollectionPaginationPairPrefix[string, uint64](req.Buyer),
+    )
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    return &types.QueryListingsByBuyerResponse{Listings: listings, Pagination: pageRes}, nil
+}
+
+func (q queryServer) ListingsByStatus(ctx context.Context, req *types.QueryListingsByStatusRequest) (*types.QueryListingsByStatusResponse, error) {
+    if req == nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+
+    listings, pageRes, err := paginateListingIndex(ctx, q.k, q.k.Listings.Indexes.Status, int32(req.Status), req.Pagination)
+    if err != nil {
+        return nil, paginationError(err)
+    }
+
+    return &types.QueryListingsByStatusResponse{Listings: listings, Pagination: pageRes}, nil
+}
+
+// checkPageRequest returns a codes.InvalidArgument error for page requests that
+// query.CollectionPaginate and paginateIndex reject, so that a caller's mistake is not
+// reported as an internal error.
+func checkPageRequest(pageReq *query.PageRequest) error {
+    if pageReq != nil && len(pageReq.Key) != 0 && pageReq.Offset != 0 {
+        return status.Error(codes.InvalidArgument, "invalid request, either offset or key is expected, got both")
+    }
+    return nil
+}
+
+// paginationError returns the gRPC status error of a failed page: request errors keep their
+// status, and anything else is an internal error.
+func paginationError(err error) error {
+    if _, ok := status.FromError(err); ok {
+        return err
+    }
+    return status.Error(codes.Internal, err.Error())
+}
+
+// paginateListingIndex pages through the listings a Multi index holds under ref in listing
+// id order, by the rules of paginateIndex.
+func paginateListingIndex[R any](
+    ctx context.Context,
+    k Keeper,
+    index *indexes.Multi[R, uint64, types.Listing],
+    ref R,
+    pageReq *query.PageRequest,
+) ([]*types.Listing, *query.PageResponse, error) {
+    iterate := func(rng collections.Ranger[collections.Pair[R, uint64]]) (collections.KeySetIterator[collections.Pair[R, uint64]], error) {
+        iter, err := index.Iterate(ctx, rng)
+        return collections.KeySetIterator[collections.Pair[R, uint64]](iter), err
+    }
+    return paginateIndex(index.KeyCodec(), collections.PairPrefix[R, uint64](ref), iterate, pageReq, nil,
+        func(key collections.Pair[R, uint64]) (*types.Listing, error) {
+            listing, err := k.Listings.Get(ctx, key.K2())
+            if err != nil {
+                return nil, err
+            }
+            return &listing, nil
+        })
+}
+
+// paginateIndex pages through the index keys under prefix, a partial index key, in key
+// order and transforms each key into a result. Multi indexes and super-prefixed keys cannot
+// be passed to query.CollectionPaginate, so this follows its rules by hand: a page starts
+// either at an offset or at the encoded index key returned as the previous page's next key,
+// and totals are only counted for offset pages. Requests with both, or with a key that is
+// not an index key under prefix, fail with codes.InvalidArgument. Keys an optional include
+// rejects are skipped as if they were not in the index, and do not count towards offsets
+// or totals.
+func paginateIndex[K, T any](
+    keyCodec collcodec.KeyCodec[K],
+    prefix K,
+    iterate func(collections.Ranger[K]) (collections.KeySetIterator[K], error),
+    pageReq *query.PageRequest,
+    include func(K) (bool, error),
+    transform func(K) (T, error),
+) ([]T, *query.PageResponse, error) {
+    if err := checkPageRequest(pageReq); err != nil {
+        return nil, nil, err
+    }
+    if pageReq == nil {
+        pageReq = &query.PageRequest{}
+    }
+    limit, countTotal := pageReq.Limit, pageReq.CountTotal
+    if limit == 0 {
+        limit, countTotal = query.DefaultLimit, true
+    }
+    countTotal = countTotal && len(pageReq.Key) == 0
+
+    rng := new(collections.Range[K]).Prefix(prefix)
+    if len(pageReq.Key) != 0 {
+        start, err := decodePageKey(keyCodec, prefix, pageReq.Key)
+        if err != nil {
+            return nil, nil, err
+        }
+        if pageReq.Reverse {
+            rng.EndInclusive(start)
+        } else {
+            rng.StartInclusive(start)
+        }
+    }
+    if pageReq.Reverse {
+        rng.Descending()
+    }
+
+    iter, err := iterate(rng)
+    if err != nil {
+        return nil, nil, err
+    }
+    defer iter.Close()
+
+    var (
+        results []T
+        nextKey []byte
+        seen    uint64
+    )
+    for ; iter.Valid(); iter.Next() {
+        key, err := iter.Key()
+        if err != nil {
+            return nil, nil, err
+        }
+        if include != nil {
+            ok, err := include(key)
+            if err != nil {
+                return nil, nil, err
+            }
+            if !ok {
+                continue
+            }
+        }
+        seen++
+        if seen <= pageReq.Offset {
+            continue
+        }
+        if uint64(len(results)) == limit {
+            if nextKey == nil {
+                if nextKey, err = collections.EncodeKeyWithPrefix(nil, keyCodec, key); err != nil {
+                    return nil, nil, err
+                }
+            }
+            if !countTotal {
+                break
+            }
+            continue
+        }
+        result, err := transform(key)
+        if err != nil {
+            return nil, nil, err
+        }
+        results = append(results, result)
+    }
+
+    pageRes := &query.PageResponse{NextKey: nextKey}
+    if countTotal {
+        pageRes.Total = seen
+    }
+    return results, pageRes, nil
+}
+
+// decodePageKey decodes the page key of paginateIndex, which must be a whole index key
+// under prefix.
+func decodePageKey[K any](keyCodec collcodec.KeyCodec[K], prefix K, bz []byte) (K, error) {
+    var zero K
+    prefixBz, err := collections.EncodeKeyWithPrefix(nil, keyCodec, prefix)
+    if err != nil {
+        return zero, err
+    }
+    if !bytes.HasPrefix(bz, prefixBz) {
+        return zero, status.Error(codes.InvalidArgument, "invalid request, key is not from this query")
+    }
+    n, key, err := keyCodec.Decode(bz)
+    if err != nil || n != len(bz) {
+        return zero, status.Error(codes.InvalidArgument, "invalid request, key is not an index key")
+    }
+    return key, nil
+}
+
+func (q queryServer) ListingsByPrice(ctx context.Context, req *types.QueryListingsByPriceRequest) (*types.QueryListingsByPriceResponse, error) {
+    if req == nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+    if err := validateDenomPair(req.AssetDenom, req.PriceDenom); err != nil {
+        return nil, err
+    }
+
+    listings, pageRes, err := paginateListingPrices(ctx, q.k, req.AssetDenom, req.PriceDenom, req.Pagination)
+    if err != nil {
+        return nil, paginationError(err)
+    }
+
+    return &types.QueryListingsByPriceResponse{Listings: listings, Pagination: pageRes}, nil
+}
+
+func (q queryServer) FloorPrice(ctx context.Context, req *types.QueryFloorPriceRequest) (*types.QueryFloorPriceResponse, error) {
+    if req == nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+    if err := validateDenomPair(req.AssetDenom, req.PriceDenom); err != nil {
+        return nil, err
+    }
+
+    floor, err := q.k.FloorPrice(ctx, req.AssetDenom, req.PriceDenom)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+    return &types.QueryFloorPriceResponse{Floor: floor}, nil
+}
+
+// validateDenomPair checks the asset and price denoms of a price query.
+func validateDenomPair(assetDenom, priceDenom string) error {
+    if err := sdk.ValidateDenom(assetDenom); err != nil {
+        return status.Errorf(codes.InvalidArgument, "invalid asset denom: %s", err)
+    }
+    if err := sdk.ValidateDenom(priceDenom); err != nil {
+        return status.Errorf(codes.InvalidArgument, "invalid price denom: %s", err)
+    }
+    return nil
+}
+
+// paginateListingPrices pages through the unexpired listings of assetDenom in priceDenom
+// cheapest first, by the rules of paginateIndex.
+func paginateListingPrices(ctx context.Context, k Keeper, assetDenom, priceDenom string, pageReq *query.PageRequest) ([]types.PricedListing, *query.PageResponse, error) {
+    index := k.Listings.Indexes.Price
+    iterate := func(rng collections.Ranger[PriceIndexKey]) (collections.KeySetIterator[PriceIndexKey], error) {
+        return index.Iterate(ctx, rng)
+    }
+    prefix := collections.QuadSuperPrefix[string, string, sdkmath.LegacyDec, uint64](assetDenom, priceDenom)
+    // like FloorPrice, skip listings past their expiry that EndBlock has not closed yet
+    include := func(key PriceIndexKey) (bool, error) {
+        listing, err := k.Listings.Get(ctx, key.K4())
         if err != nil {
-            return nil, status.Error(codes.Internal, "internal error")
+            return false, err
         }
-        vv := v
-        listings = append(listings, &vv)
+        return !k.isExpired(ctx, listing), nil
     }
-    // Note: simple listing without pagination; SDK pagination can be wired later
-    return &types.QueryListingsResponse{Listings: listings}, nil
+    return paginateIndex(index.KeyCodec(), prefix, iterate, pageReq, include, func(key PriceIndexKey) (types.PricedListing, error) {
+        listing, err := k.Listings.Get(ctx, key.K4())
+        if err != nil {
+            return types.PricedListing{}, err
+        }
+        return types.PricedListing{
+            Listing:   listing,
+            UnitPrice: sdk.NewDecCoinFromDec(priceDenom, key.K3()),
+        }, nil
+    })
 }
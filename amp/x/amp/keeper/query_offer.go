@@ -0,0 +1,89 @@
+package keeper
+
+import (
+    "context"
+    "errors"
+
+    "cosmossdk.io/collections"
+    "github.com/cosmos/cosmos-sdk/types/query"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+
+    "amp/x/amp/types"
+)
+
+func (q queryServer) Offer(ctx context.Context, req *types.QueryOfferRequest) (*types.QueryOfferResponse, error) {
+    if req == nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+
+    offer, err := q.k.Offers.Get(ctx, req.Id)
+    if err != nil {
+        if errors.Is(err, collections.ErrNotFound) {
+            return nil, status.Error(codes.NotFound, "offer not found")
+        }
+        return nil, status.Error(codes.Internal, "internal error")
+    }
+    return &types.QueryOfferResponse{Offer: &offer}, nil
+}
+
+func (q queryServer) OffersByListing(ctx context.Context, req *types.QueryOffersByListingRequest) (*types.QueryOffersByListingResponse, error) {
+    if req == nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+
+    if err := checkPageRequest(req.Pagination); err != nil {
+        return nil, err
+    }
+
+    offers, pageRes, err := query.CollectionPaginate(
+        ctx,
+        q.k.OffersByListing,
+        req.Pagination,
+        func(key collections.Pair[uint64, uint64], _ collections.NoValue) (*types.Offer, error) {
+            offer, err := q.k.Offers.Get(ctx, key.K2())
+            if err != nil {
+                return nil, err
+            }
+            return &offer, nil
+        },
+        query.WithCollectionPaginationPairPrefix[uint64, uint64](req.ListingId),
+    )
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    return &types.QueryOffersByListingResponse{Offers: offers, Pagination: pageRes}, nil
+}
+
+func (q queryServer) OffersByBuyer(ctx context.Context, req *types.QueryOffersByBuyerRequest) (*types.QueryOffersByBuyerResponse, error) {
+    if req == nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+    if _, err := q.k.addressCodec.StringToBytes(req.Buyer); err != nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid buyer address")
+    }
+
+    if err := checkPageRequest(req.Pagination); err != nil {
+        return nil, err
+    }
+
+    offers, pageRes, err := query.CollectionPaginate(
+        ctx,
+        q.k.OffersByBuyer,
+        req.Pagination,
+        func(key collections.Pair[string, uint64], _ collections.NoValue) (*types.Offer, error) {
+            offer, err := q.k.Offers.Get(ctx, key.K2())
+            if err != nil {
+                return nil, err
+            }
+            return &offer, nil
+        },
+        query.WithCollectionPaginationPairPrefix[string, uint64](req.Buyer),
+    )
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+
+    return &types.QueryOffersByBuyerResponse{Offers: offers, Pagination: pageRes}, nil
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+    "context"
+    "errors"
+
+    "cosmossdk.io/collections"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+
+    "amp/x/amp/types"
+)
+
+func (q queryServer) Order(ctx context.Context, req *types.QueryOrderRequest) (*types.QueryOrderResponse, error) {
+    if req == nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+    order, err := q.k.Orders.Get(ctx, req.Id)
+    if err != nil {
+        if errors.Is(err, collections.ErrNotFound) {
+            return nil, status.Error(codes.NotFound, "order not found")
+        }
+        return nil, status.Error(codes.Internal, "internal error")
+    }
+    return &types.QueryOrderResponse{Order: &order}, nil
+}
+
+func (q queryServer) OrderBookDepth(ctx context.Context, req *types.QueryOrderBookDepthRequest) (*types.QueryOrderBookDepthResponse, error) {
+    if req == nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+    limit := req.Limit
+    if limit == 0 {
+        limit = types.DefaultDepthLimit
+    }
+    if limit > types.MaxDepthLimit {
+        return nil, status.Errorf(codes.InvalidArgument, "limit must not exceed %d", types.MaxDepthLimit)
+    }
+
+    bids, err := q.k.PriceLevels(ctx, types.OrderSide_ORDER_SIDE_BUY, req.AssetDenom, req.PriceDenom, limit)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+    asks, err := q.k.PriceLevels(ctx, types.OrderSide_ORDER_SIDE_SELL, req.AssetDenom, req.PriceDenom, limit)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+    return &types.QueryOrderBookDepthResponse{Bids: bids, Asks: asks}, nil
+}
+
+func (q queryServer) BestBidAsk(ctx context.Context, req *types.QueryBestBidAskRequest) (*types.QueryBestBidAskResponse, error) {
+    if req == nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+
+    res := &types.QueryBestBidAskResponse{}
+    bids, err := q.k.PriceLevels(ctx, types.OrderSide_ORDER_SIDE_BUY, req.AssetDenom, req.PriceDenom, 1)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+    if len(bids) > 0 {
+        res.BestBid = &bids[0]
+    }
+    asks, err := q.k.PriceLevels(ctx, types.OrderSide_ORDER_SIDE_SELL, req.AssetDenom, req.PriceDenom, 1)
+    if err != nil {
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+    if len(asks) > 0 {
+        res.BestAsk = &asks[0]
+    }
+    return res, nil
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+    "context"
+    "errors"
+
+    "cosmossdk.io/collections"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+
+    "amp/x/amp/types"
+)
+
+func (q queryServer) ReferrerStats(ctx context.Context, req *types.QueryReferrerStatsRequest) (*types.QueryReferrerStatsResponse, error) {
+    if req == nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+    stats, err := q.k.ReferrerStats.Get(ctx, req.Referrer)
+    if err != nil {
+        if errors.Is(err, collections.ErrNotFound) {
+            return nil, status.Error(codes.NotFound, "referrer not found")
+        }
+        return nil, status.Error(codes.Internal, "internal error")
+    }
+    return &types.QueryReferrerStatsResponse{Stats: &stats}, nil
+}
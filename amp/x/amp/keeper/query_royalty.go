@@ -0,0 +1,26 @@
+package keeper
+
+import (
+    "context"
+    "errors"
+
+    "cosmossdk.io/collections"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+
+    "amp/x/amp/types"
+)
+
+func (q queryServer) Royalty(ctx context.Context, req *types.QueryRoyaltyRequest) (*types.QueryRoyaltyResponse, error) {
+    if req == nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+    royalty, err := q.k.Royalties.Get(ctx, collections.Join(int32(req.Kind), req.Asset))
+    if err != nil {
+        if errors.Is(err, collections.ErrNotFound) {
+            return nil, status.Error(codes.NotFound, "royalty not found")
+        }
+        return nil, status.Error(codes.Internal, "internal error")
+    }
+    return &types.QueryRoyaltyResponse{Royalty: &royalty}, nil
+}
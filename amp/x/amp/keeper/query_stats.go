@@ -0,0 +1,37 @@
+package keeper
+
+import (
+    "context"
+    "errors"
+
+    "cosmossdk.io/collections"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+
+    "amp/x/amp/types"
+)
+
+func (q queryServer) MarketStats(ctx context.Context, req *types.QueryMarketStatsRequest) (*types.QueryMarketStatsResponse, error) {
+    if req == nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+    if req.EpochNumber < 0 {
+        return nil, status.Error(codes.InvalidArgument, "epoch number must not be negative")
+    }
+
+    if req.EpochNumber == 0 {
+        stats, err := q.k.GetMarketStats(ctx)
+        if err != nil {
+            return nil, status.Error(codes.Internal, err.Error())
+        }
+        return &types.QueryMarketStatsResponse{Stats: stats}, nil
+    }
+    stats, err := q.k.StatsSnapshots.Get(ctx, req.EpochNumber)
+    if err != nil {
+        if errors.Is(err, collections.ErrNotFound) {
+            return nil, status.Error(codes.NotFound, "no stats snapshot for epoch")
+        }
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+    return &types.QueryMarketStatsResponse{Stats: stats}, nil
+}
@@ -0,0 +1,22 @@
+package keeper
+
+import (
+    "context"
+
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+
+    "amp/x/amp/types"
+)
+
+func (q queryServer) Treasury(ctx context.Context, req *types.QueryTreasuryRequest) (*types.QueryTreasuryResponse, error) {
+    if req == nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+    addr, balance := q.k.TreasuryBalance(ctx)
+    addrStr, err := q.k.addressCodec.BytesToString(addr)
+    if err != nil {
+        return nil, status.Error(codes.Internal, "internal error")
+    }
+    return &types.QueryTreasuryResponse{Address: addrStr, Balance: balance}, nil
+}
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+    "context"
+    "errors"
+    "time"
+
+    sdk "github.com/cosmos/cosmos-sdk/types"
+    "google.golang.org/grpc/codes"
+    "google.golang.org/grpc/status"
+
+    "amp/x/amp/types"
+)
+
+func (q queryServer) TWAP(ctx context.Context, req *types.QueryTWAPRequest) (*types.QueryTWAPResponse, error) {
+    if req == nil {
+        return nil, status.Error(codes.InvalidArgument, "invalid request")
+    }
+    if err := validateDenomPair(req.AssetDenom, req.PriceDenom); err != nil {
+        return nil, err
+    }
+    if req.Window == 0 || req.Window > uint64(1<<63-1)/uint64(time.Second) {
+        return nil, status.Error(codes.InvalidArgument, "invalid window")
+    }
+
+    twap, err := q.k.GetTWAP(ctx, req.AssetDenom, req.PriceDenom, time.Duration(req.Window)*time.Second)
+    switch {
+    case errors.Is(err, types.ErrInvalidTWAPWindow):
+        return nil, status.Error(codes.InvalidArgument, err.Error())
+    case errors.Is(err, types.ErrNoPriceHistory):
+        return nil, status.Error(codes.NotFound, err.Error())
+    case err != nil:
+        return nil, status.Error(codes.Internal, err.Error())
+    }
+    return &types.QueryTWAPResponse{Twap: sdk.NewDecCoinFromDec(req.PriceDenom, twap)}, nil
+}
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+    "context"
+    "errors"
+
+    "cosmossdk.io/collections"
+    errorsmod "cosmossdk.io/errors"
+    sdkmath "cosmossdk.io/math"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+
+    "amp/x/amp/types"
+)
+
+// payReferrals pays each non-empty referrer Params.ReferralShare of fee from payer and adds
+// it to the referrer's totals.
+func (k Keeper) payReferrals(ctx context.Context, payer sdk.AccAddress, fee sdk.Coin, referrers []string) ([]types.ReferralPayment, error) {
+    if !fee.IsPositive() || len(referrers) == 0 {
+        return nil, nil
+    }
+    params, err := k.Params.Get(ctx)
+    if err != nil {
+        return nil, err
+    }
+    share := params.ReferralShare
+    if share.IsNil() || !share.IsPositive() {
+        return nil, nil
+    }
+    amount := sdk.NewCoin(fee.Denom, sdkmath.LegacyNewDecFromInt(fee.Amount).Mul(share).TruncateInt())
+    if !amount.IsPositive() {
+        return nil, nil
+    }
+
+    var payments []types.ReferralPayment
+    for _, referrer := range referrers {
+        if referrer == "" {
+            continue
+        }
+        referrerBz, err := k.addressCodec.StringToBytes(referrer)
+        if err != nil {
+            return nil, err
+        }
+        if err := k.bankKeeper.SendCoins(ctx, payer, referrerBz, sdk.NewCoins(amount)); err != nil {
+            return nil, err
+        }
+
+        stats, err := k.ReferrerStats.Get(ctx, referrer)
+        if errors.Is(err, collections.ErrNotFound) {
+            stats = types.ReferrerStats{Referrer: referrer}
+        } else if err != nil {
+            return nil, err
+        }
+        stats.Earned = stats.Earned.Add(amount)
+        stats.Sales++
+        if err := k.ReferrerStats.Set(ctx, referrer, stats); err != nil {
+            return nil, err
+        }
+        payments = append(payments, types.ReferralPayment{Referrer: referrer, Amount: amount})
+    }
+    return payments, nil
+}
+
+// referrerString returns the bech32 form of an optional referrer, which may not be party
+// itself so that nobody earns a referral on their own sale.
+func (k Keeper) referrerString(party, referrer sdk.AccAddress) (string, error) {
+    if len(referrer) == 0 {
+        return "", nil
+    }
+    if referrer.Equals(party) {
+        return "", errorsmod.Wrap(types.ErrInvalidReferrer, "cannot refer yourself")
+    }
+    return k.addressCodec.BytesToString(referrer)
+}
+
+// GetReferrerStats returns the totals of a referrer and a boolean whether it exists.
+func (k Keeper) GetReferrerStats(ctx context.Context, referrer string) (types.ReferrerStats, bool) {
+    stats, err := k.ReferrerStats.Get(ctx, referrer)
+    if err != nil {
+        return types.ReferrerStats{}, false
+    }
+    return stats, true
+}
+
+// referralAttributes returns a referrer and a referral attribute per payment for legacy events.
+func referralAttributes(payments []types.ReferralPayment) []sdk.Attribute {
+    attrs := make([]sdk.Attribute, 0, 2*len(payments))
+    for _, p := range payments {
+        attrs = append(attrs,
+            sdk.NewAttribute(types.AttributeKeyReferrer, p.Referrer),
+            sdk.NewAttribute(types.AttributeKeyReferral, p.Amount.String()),
+        )
+    }
+    return attrs
+}
@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"amp/x/amp/keeper"
+	"amp/x/amp/types"
+)
+
+func TestReferrals(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.CommissionRate = sdkmath.LegacyNewDecWithPrec(1, 1)
+	params.ReferralShare = sdkmath.LegacyNewDecWithPrec(2, 1)
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	seller := sdk.AccAddress("seller______________")
+	buyer := sdk.AccAddress("buyer_______________")
+	wallet := sdk.AccAddress("wallet______________")
+	storefront := sdk.AccAddress("storefront__________")
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 20)))
+	f.bankKeeper.fund(buyer, sdk.NewCoins(sdk.NewInt64Coin("stake", 2000)))
+
+	asset, price := sdk.NewCoins(sdk.NewInt64Coin("token", 10)), sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))
+	_, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: asset, Price: price, Referrer: seller})
+	require.ErrorIs(t, err, types.ErrInvalidReferrer)
+	id, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: asset, Price: price, Referrer: storefront})
+	require.NoError(t, err)
+	listing, _ := f.keeper.GetListing(ctx, id)
+	require.Equal(t, storefront.String(), listing.Referrer)
+
+	require.ErrorIs(t, f.keeper.BuyItem(ctx, buyer, id, types.BuyOptions{Referrer: buyer}), types.ErrInvalidReferrer)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.BuyItem(ctx, buyer, id, types.BuyOptions{Referrer: wallet}))
+
+	// each referrer earns 20% of the 100stake commission, the fee collector the rest
+	require.Equal(t, int64(900), f.bankKeeper.balance(seller, "stake").Int64())
+	require.Equal(t, int64(20), f.bankKeeper.balance(storefront, "stake").Int64())
+	require.Equal(t, int64(20), f.bankKeeper.balance(wallet, "stake").Int64())
+	require.Equal(t, int64(60), f.bankKeeper.balance(authtypes.NewModuleAddress(authtypes.FeeCollectorName), "stake").Int64())
+
+	var bought *types.EventItemBought
+	for _, ev := range ctx.EventManager().Events() {
+		msg, err := sdk.ParseTypedEvent(abci.Event(ev))
+		if err == nil {
+			if e, ok := msg.(*types.EventItemBought); ok {
+				bought = e
+			}
+		}
+	}
+	require.NotNil(t, bought)
+	require.Equal(t, []types.ReferralPayment{
+		{Referrer: storefront.String(), Amount: sdk.NewInt64Coin("stake", 20)},
+		{Referrer: wallet.String(), Amount: sdk.NewInt64Coin("stake", 20)},
+	}, bought.Referrals)
+
+	// a second sale referred by the storefront adds to its totals
+	id, err = f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: asset, Price: price, Referrer: storefront})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.BuyItem(ctx, buyer, id, types.BuyOptions{}))
+
+	res, err := qs.ReferrerStats(ctx, &types.QueryReferrerStatsRequest{Referrer: storefront.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 40)), res.Stats.Earned)
+	require.Equal(t, uint64(2), res.Stats.Sales)
+	_, err = qs.ReferrerStats(ctx, &types.QueryReferrerStatsRequest{Referrer: buyer.String()})
+	require.Error(t, err)
+
+	params.ReferralShare = sdkmath.LegacyNewDecWithPrec(6, 1)
+	require.ErrorIs(t, params.Validate(), types.ErrInvalidReferrer)
+}
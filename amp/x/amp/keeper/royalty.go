@@ -0,0 +1,247 @@
+package keeper
+
+import (
+    "context"
+    "errors"
+
+    "cosmossdk.io/collections"
+    errorsmod "cosmossdk.io/errors"
+    sdkmath "cosmossdk.io/math"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+
+    "amp/x/amp/types"
+)
+
+// SetRoyalty registers recipient to receive rate of the price of every later listing sale
+// of a denom or NFT class. Only the asset's creator may set it: the first address that
+// listed it, or whoever the module authority assigned with SetCreator. x/nft records no
+// class owners, so the first lister of any token of a class stands in for its owner. rate
+// may not exceed Params.MaxRoyaltyRate.
+func (k Keeper) SetRoyalty(ctx context.Context, creator sdk.AccAddress, kind types.RoyaltyAssetKind, asset string, recipient sdk.AccAddress, rate sdkmath.LegacyDec) error {
+    if kind != types.RoyaltyAssetKind_ROYALTY_ASSET_KIND_DENOM && kind != types.RoyaltyAssetKind_ROYALTY_ASSET_KIND_NFT_CLASS {
+        return errorsmod.Wrapf(types.ErrInvalidRoyalty, "unknown asset kind %s", kind)
+    }
+    if rate.IsNil() || rate.IsNegative() {
+        return errorsmod.Wrap(types.ErrInvalidRoyalty, "rate must not be negative")
+    }
+    params, err := k.Params.Get(ctx)
+    if err != nil {
+        return err
+    }
+    if maxRate := params.MaxRoyaltyRate; maxRate.IsNil() || rate.GT(maxRate) {
+        return errorsmod.Wrapf(types.ErrInvalidRoyalty, "rate exceeds the maximum %s", maxRate)
+    }
+
+    creatorStr, _ := k.addressCodec.BytesToString(creator)
+    key := collections.Join(int32(kind), asset)
+    assigned, err := k.Creators.Get(ctx, key)
+    if err != nil {
+        if errors.Is(err, collections.ErrNotFound) {
+            return errorsmod.Wrapf(types.ErrUnauthorized, "%s was never listed", asset)
+        }
+        return err
+    }
+    if assigned != creatorStr {
+        return errorsmod.Wrapf(types.ErrUnauthorized, "only %s may set the royalty of %s", assigned, asset)
+    }
+
+    recipientStr, err := k.addressCodec.BytesToString(recipient)
+    if err != nil {
+        return err
+    }
+    if err := k.Royalties.Set(ctx, key, types.Royalty{
+        Kind:      kind,
+        Asset:     asset,
+        Creator:   creatorStr,
+        Recipient: recipientStr,
+        Rate:      rate,
+    }); err != nil {
+        return err
+    }
+
+    sdkCtx := sdk.UnwrapSDKContext(ctx)
+    _ = sdkCtx.EventManager().EmitTypedEvent(&types.EventRoyaltySet{
+        Kind:      kind,
+        Asset:     asset,
+        Creator:   creatorStr,
+        Recipient: recipientStr,
+        Rate:      rate,
+    })
+    sdkCtx.EventManager().EmitEvent(
+        sdk.NewEvent(
+            types.EventTypeRoyaltySet,
+            sdk.NewAttribute(types.AttributeKeyKind, kind.String()),
+            sdk.NewAttribute(types.AttributeKeyAsset, asset),
+            sdk.NewAttribute(types.AttributeKeyCreator, creatorStr),
+            sdk.NewAttribute(types.AttributeKeyRecipient, recipientStr),
+            sdk.NewAttribute(types.AttributeKeyRate, rate.String()),
+        ),
+    )
+    return nil
+}
+
+// SetCreator lets the module authority override the recorded creator of a denom or NFT
+// class, for instance when the first lister was not its actual issuer. An empty creator
+// revokes the assignment, and a royalty registered by another creator is removed.
+func (k Keeper) SetCreator(ctx context.Context, kind types.RoyaltyAssetKind, asset, creator string) error {
+    if kind != types.RoyaltyAssetKind_ROYALTY_ASSET_KIND_DENOM && kind != types.RoyaltyAssetKind_ROYALTY_ASSET_KIND_NFT_CLASS {
+        return errorsmod.Wrapf(types.ErrInvalidRoyalty, "unknown asset kind %s", kind)
+    }
+    if asset == "" {
+        return errorsmod.Wrap(types.ErrInvalidRoyalty, "asset must not be empty")
+    }
+    key := collections.Join(int32(kind), asset)
+    if creator == "" {
+        if err := k.Creators.Remove(ctx, key); err != nil {
+            return err
+        }
+    } else {
+        if _, err := k.addressCodec.StringToBytes(creator); err != nil {
+            return errorsmod.Wrapf(err, "invalid creator address %s", creator)
+        }
+        if err := k.Creators.Set(ctx, key, creator); err != nil {
+            return err
+        }
+    }
+
+    royalty, err := k.Royalties.Get(ctx, key)
+    if err == nil && royalty.Creator != creator {
+        err = k.Royalties.Remove(ctx, key)
+    } else if errors.Is(err, collections.ErrNotFound) {
+        err = nil
+    }
+    if err != nil {
+        return err
+    }
+
+    sdkCtx := sdk.UnwrapSDKContext(ctx)
+    _ = sdkCtx.EventManager().EmitTypedEvent(&types.EventCreatorSet{
+        Kind:    kind,
+        Asset:   asset,
+        Creator: creator,
+    })
+    sdkCtx.EventManager().EmitEvent(
+        sdk.NewEvent(
+            types.EventTypeCreatorSet,
+            sdk.NewAttribute(types.AttributeKeyKind, kind.String()),
+            sdk.NewAttribute(types.AttributeKeyAsset, asset),
+            sdk.NewAttribute(types.AttributeKeyCreator, creator),
+        ),
+    )
+    return nil
+}
+
+// recordCreators records seller as the creator of every denom or NFT class of a new
+// listing that has none yet.
+func (k Keeper) recordCreators(ctx context.Context, seller string, asset sdk.Coins, nft *types.NFTAsset) error {
+    for _, key := range royaltyKeys(asset, nft) {
+        has, err := k.Creators.Has(ctx, key)
+        if err != nil {
+            return err
+        }
+        if has {
+            continue
+        }
+        if err := k.Creators.Set(ctx, key, seller); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+// saleRoyalties returns the royalties owed out of price for a listing sale of asset or
+// nft. The price of a bundle is apportioned equally across its denoms. Registered rates
+// are capped at the current Params.MaxRoyaltyRate, and royalties never take more than
+// what is left for the seller after commission.
+func (k Keeper) saleRoyalties(ctx context.Context, price sdk.Coin, asset sdk.Coins, nft *types.NFTAsset) ([]types.RoyaltyPayment, error) {
+    fee, _, err := k.commission(ctx, price)
+    if err != nil {
+        return nil, err
+    }
+    return k.quotedSaleRoyalties(ctx, price, fee, asset, nft)
+}
+
+// quotedSaleRoyalties is saleRoyalties for a sale whose commission fee was fixed beforehand.
+func (k Keeper) quotedSaleRoyalties(ctx context.Context, price, fee sdk.Coin, asset sdk.Coins, nft *types.NFTAsset) ([]types.RoyaltyPayment, error) {
+    params, err := k.Params.Get(ctx)
+    if err != nil {
+        return nil, err
+    }
+    maxRate := params.MaxRoyaltyRate
+    keys := royaltyKeys(asset, nft)
+    if maxRate.IsNil() || !maxRate.IsPositive() || len(keys) == 0 {
+        return nil, nil
+    }
+
+    available := price.Sub(fee)
+    share := sdkmath.LegacyNewDecFromInt(price.Amount.QuoRaw(int64(len(keys))))
+
+    var payments []types.RoyaltyPayment
+    for _, key := range keys {
+        royalty, err := k.Royalties.Get(ctx, key)
+        if errors.Is(err, collections.ErrNotFound) {
+            continue
+        } else if err != nil {
+            return nil, err
+        }
+        amount := share.Mul(sdkmath.LegacyMinDec(royalty.Rate, maxRate)).TruncateInt()
+        amount = sdkmath.MinInt(amount, available.Amount)
+        if !amount.IsPositive() {
+            continue
+        }
+        available.Amount = available.Amount.Sub(amount)
+        payments = append(payments, types.RoyaltyPayment{
+            Kind:      royalty.Kind,
+            Asset:     royalty.Asset,
+            Recipient: royalty.Recipient,
+            Amount:    sdk.NewCoin(price.Denom, amount),
+        })
+    }
+    return payments, nil
+}
+
+// GetRoyalty returns the royalty registered for an asset and a boolean whether it exists.
+func (k Keeper) GetRoyalty(ctx context.Context, kind types.RoyaltyAssetKind, asset string) (types.Royalty, bool) {
+    royalty, err := k.Royalties.Get(ctx, collections.Join(int32(kind), asset))
+    if err != nil {
+        return types.Royalty{}, false
+    }
+    return royalty, true
+}
+
+// royaltyKeys returns the registry keys of the assets a listing sells.
+func royaltyKeys(asset sdk.Coins, nft *types.NFTAsset) []collections.Pair[int32, string] {
+    if nft != nil {
+        return []collections.Pair[int32, string]{
+            collections.Join(int32(types.RoyaltyAssetKind_ROYALTY_ASSET_KIND_NFT_CLASS), nft.ClassId),
+        }
+    }
+    keys := make([]collections.Pair[int32, string], 0, len(asset))
+    for _, coin := range asset {
+        keys = append(keys, collections.Join(int32(types.RoyaltyAssetKind_ROYALTY_ASSET_KIND_DENOM), coin.Denom))
+    }
+    return keys
+}
+
+// royaltyTotal sums royalty payments for legacy event attributes.
+func royaltyTotal(payments []types.RoyaltyPayment) sdk.Coins {
+    total := sdk.NewCoins()
+    for _, p := range payments {
+        total = total.Add(p.Amount)
+    }
+    return total
+}
+
+// payRoyalties sends each royalty from payer to its recipient.
+func (k Keeper) payRoyalties(ctx context.Context, payer sdk.AccAddress, payments []types.RoyaltyPayment) error {
+    for _, p := range payments {
+        recipient, err := k.addressCodec.StringToBytes(p.Recipient)
+        if err != nil {
+            return err
+        }
+        if err := k.bankKeeper.SendCoins(ctx, payer, recipient, sdk.NewCoins(p.Amount)); err != nil {
+            return err
+        }
+    }
+    return nil
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"amp/x/amp/keeper"
+	"amp/x/amp/types"
+)
+
+func TestRoyaltiesOnResale(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.CommissionRate = sdkmath.LegacyNewDecWithPrec(1, 1)
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	creator := sdk.AccAddress("creator_____________")
+	artist := sdk.AccAddress("artist______________")
+	collector := sdk.AccAddress("collector___________")
+	buyer := sdk.AccAddress("buyer_______________")
+	f.bankKeeper.fund(creator, sdk.NewCoins(sdk.NewInt64Coin("art", 10)))
+	f.bankKeeper.fund(collector, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	f.bankKeeper.fund(buyer, sdk.NewCoins(sdk.NewInt64Coin("stake", 200)))
+
+	denom := types.RoyaltyAssetKind_ROYALTY_ASSET_KIND_DENOM
+	rate := sdkmath.LegacyNewDecWithPrec(5, 2)
+	require.ErrorIs(t, f.keeper.SetRoyalty(ctx, creator, denom, "art", artist, rate), types.ErrUnauthorized)
+
+	// the first lister of an asset becomes its creator
+	first, err := f.keeper.ListItem(ctx, creator, types.ListingTerms{Title: "t", Description: "d", Asset: sdk.NewCoins(sdk.NewInt64Coin("art", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.BuyItem(ctx, collector, first, types.BuyOptions{}))
+
+	require.ErrorIs(t, f.keeper.SetRoyalty(ctx, collector, denom, "art", collector, rate), types.ErrUnauthorized)
+	require.ErrorIs(t, f.keeper.SetRoyalty(ctx, creator, denom, "art", artist, sdkmath.LegacyNewDecWithPrec(2, 1)), types.ErrInvalidRoyalty)
+	require.NoError(t, f.keeper.SetRoyalty(ctx, creator, denom, "art", artist, rate))
+
+	res, err := qs.Royalty(ctx, &types.QueryRoyaltyRequest{Kind: denom, Asset: "art"})
+	require.NoError(t, err)
+	require.Equal(t, artist.String(), res.Royalty.Recipient)
+
+	// the resale pays the royalty out of the price, next to the commission
+	resale, err := f.keeper.ListItem(ctx, collector, types.ListingTerms{Title: "t", Description: "d", Asset: sdk.NewCoins(sdk.NewInt64Coin("art", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 200))})
+	require.NoError(t, err)
+	quote, err := f.keeper.QuoteBuy(ctx, buyer, resale, sdkmath.Int{}, "")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("stake", 170), quote.SellerAmount)
+	require.Len(t, quote.Royalties, 1)
+
+	require.NoError(t, f.keeper.BuyItem(ctx, buyer, resale, types.BuyOptions{}))
+	require.Equal(t, int64(10), f.bankKeeper.balance(artist, "stake").Int64())
+	require.Equal(t, int64(170), f.bankKeeper.balance(collector, "stake").Int64())
+	require.Equal(t, int64(10), f.bankKeeper.balance(buyer, "art").Int64())
+
+	var bought *types.EventItemBought
+	for _, ev := range ctx.EventManager().Events() {
+		if ev.Type == "amp.amp.v1.EventItemBought" {
+			msg, err := sdk.ParseTypedEvent(abci.Event(ev))
+			require.NoError(t, err)
+			bought = msg.(*types.EventItemBought)
+		}
+	}
+	require.NotNil(t, bought)
+	require.Equal(t, []types.RoyaltyPayment{{
+		Kind:      denom,
+		Asset:     "art",
+		Recipient: artist.String(),
+		Amount:    sdk.NewInt64Coin("stake", 10),
+	}}, bought.Royalties)
+}
+
+func TestRoyaltiesOnAuctionsAndOrders(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	params := types.DefaultParams()
+	params.CommissionRate = sdkmath.LegacyNewDecWithPrec(1, 1)
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	creator := sdk.AccAddress("creator_____________")
+	artist := sdk.AccAddress("artist______________")
+	seller := sdk.AccAddress("seller______________")
+	buyer := sdk.AccAddress("buyer_______________")
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("art", 20)))
+	f.bankKeeper.fund(buyer, sdk.NewCoins(sdk.NewInt64Coin("stake", 200)))
+
+	denom := types.RoyaltyAssetKind_ROYALTY_ASSET_KIND_DENOM
+	require.NoError(t, f.keeper.SetCreator(ctx, denom, "art", creator.String()))
+	require.NoError(t, f.keeper.SetRoyalty(ctx, creator, denom, "art", artist, sdkmath.LegacyNewDecWithPrec(5, 2)))
+
+	// the winning bid pays the royalty next to the commission
+	id, err := f.keeper.CreateAuction(ctx, seller, "t", "d", sdk.NewInt64Coin("art", 10), sdk.NewInt64Coin("stake", 100), 2000)
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.PlaceBid(ctx, buyer, id, sdk.NewInt64Coin("stake", 100)))
+	require.NoError(t, f.keeper.SettleAuction(ctx.WithBlockTime(time.Unix(2000, 0)), id))
+	require.Equal(t, int64(5), f.bankKeeper.balance(artist, "stake").Int64())
+	require.Equal(t, int64(85), f.bankKeeper.balance(seller, "stake").Int64())
+
+	// so does an order book fill
+	_, _, err = f.keeper.PlaceOrder(ctx, seller, types.OrderSide_ORDER_SIDE_SELL, "art", sdk.NewInt64Coin("stake", 4), sdkmath.NewInt(10))
+	require.NoError(t, err)
+	_, filled, err := f.keeper.PlaceOrder(ctx, buyer, types.OrderSide_ORDER_SIDE_BUY, "art", sdk.NewInt64Coin("stake", 4), sdkmath.NewInt(10))
+	require.NoError(t, err)
+	require.Equal(t, int64(10), filled.Int64())
+	require.Equal(t, int64(7), f.bankKeeper.balance(artist, "stake").Int64())
+	require.Equal(t, int64(119), f.bankKeeper.balance(seller, "stake").Int64())
+
+	// assigning another creator drops the royalty of the previous one
+	require.NoError(t, f.keeper.SetCreator(ctx, denom, "art", artist.String()))
+	_, found := f.keeper.GetRoyalty(ctx, denom, "art")
+	require.False(t, found)
+	require.ErrorIs(t, f.keeper.SetRoyalty(ctx, creator, denom, "art", creator, sdkmath.LegacyNewDecWithPrec(5, 2)), types.ErrUnauthorized)
+	require.NoError(t, f.keeper.SetCreator(ctx, denom, "art", ""))
+	require.ErrorIs(t, f.keeper.SetRoyalty(ctx, artist, denom, "art", artist, sdkmath.LegacyNewDecWithPrec(5, 2)), types.ErrUnauthorized)
+}
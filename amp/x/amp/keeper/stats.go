@@ -0,0 +1,137 @@
+package keeper
+
+import (
+    "context"
+    "errors"
+
+    "cosmossdk.io/collections"
+    sdkmath "cosmossdk.io/math"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+    epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+
+    "amp/x/amp/types"
+)
+
+// recordSale adds a listing sale, auction settlement or order book fill that settled for
+// price, of which fee was commission, to the running market stats. Sales are recorded when their payment settles, so a delivery
+// escrow sale only counts once it is released, or resolved with what the seller side kept.
+// Unit prices, which also feed the TWAP accumulator, are only tracked for sales of a single
+// coin denom; filled is empty for sales whose price was partly refunded.
+func (k Keeper) recordSale(ctx context.Context, price, fee sdk.Coin, filled sdk.Coins, nft *types.NFTAsset) error {
+    stats, err := k.getDenomStats(ctx, price.Denom)
+    if err != nil {
+        return err
+    }
+    stats.Volume = stats.Volume.Add(price.Amount)
+    stats.Sales++
+    if fee.IsPositive() {
+        stats.Fees = stats.Fees.Add(fee.Amount)
+    }
+    if err := k.DenomStats.Set(ctx, price.Denom, stats); err != nil {
+        return err
+    }
+
+    if nft != nil || len(filled) != 1 || !filled[0].IsPositive() {
+        return nil
+    }
+    key := collections.Join(filled[0].Denom, price.Denom)
+    asset, err := k.AssetStats.Get(ctx, key)
+    if errors.Is(err, collections.ErrNotFound) {
+        asset = types.AssetStats{AssetDenom: filled[0].Denom}
+    } else if err != nil {
+        return err
+    }
+    unitPrice := sdk.NewDecCoinFromDec(price.Denom, sdkmath.LegacyNewDecFromInt(price.Amount).QuoInt(filled[0].Amount))
+    asset.LastPrice = unitPrice
+    if asset.AllTimeHigh.Amount.IsNil() || unitPrice.Amount.GT(asset.AllTimeHigh.Amount) {
+        asset.AllTimeHigh = unitPrice
+    }
+    asset.LastSaleAt = sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+    if err := k.AssetStats.Set(ctx, key, asset); err != nil {
+        return err
+    }
+    return k.accumulatePrice(ctx, filled[0].Denom, price.Denom, unitPrice.Amount, filled[0].Amount)
+}
+
+// getDenomStats returns the running totals of a price denom, zeroed if it has no sales yet.
+func (k Keeper) getDenomStats(ctx context.Context, denom string) (types.DenomStats, error) {
+    stats, err := k.DenomStats.Get(ctx, denom)
+    if errors.Is(err, collections.ErrNotFound) {
+        return types.DenomStats{Denom: denom, Volume: sdkmath.ZeroInt(), Fees: sdkmath.ZeroInt()}, nil
+    }
+    return stats, err
+}
+
+// GetMarketStats returns the running market stats, ordered by price denom and then by
+// asset denom.
+func (k Keeper) GetMarketStats(ctx context.Context) (types.MarketStats, error) {
+    var stats types.MarketStats
+    err := k.DenomStats.Walk(ctx, nil, func(_ string, denom types.DenomStats) (bool, error) {
+        stats.Denoms = append(stats.Denoms, denom)
+        return false, nil
+    })
+    if err != nil {
+        return types.MarketStats{}, err
+    }
+    err = k.AssetStats.Walk(ctx, nil, func(_ collections.Pair[string, string], asset types.AssetStats) (bool, error) {
+        stats.Assets = append(stats.Assets, asset)
+        return false, nil
+    })
+    if err != nil {
+        return types.MarketStats{}, err
+    }
+    return stats, nil
+}
+
+// SnapshotMarketStats stores the running market stats as the snapshot of epochNumber and
+// prunes the snapshots that fall out of the latest Params.StatsSnapshotRetention.
+func (k Keeper) SnapshotMarketStats(ctx context.Context, epochNumber int64) error {
+    stats, err := k.GetMarketStats(ctx)
+    if err != nil {
+        return err
+    }
+    stats.EpochNumber = epochNumber
+    stats.Time = sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+    if err := k.StatsSnapshots.Set(ctx, epochNumber, stats); err != nil {
+        return err
+    }
+
+    params, err := k.Params.Get(ctx)
+    if err != nil {
+        return err
+    }
+    if params.StatsSnapshotRetention == 0 {
+        return nil
+    }
+    cutoff := epochNumber - int64(params.StatsSnapshotRetention)
+    return k.StatsSnapshots.Clear(ctx, new(collections.Range[int64]).EndInclusive(cutoff))
+}
+
+// Hooks wraps the keeper to implement the x/epochs hooks.
+type Hooks struct {
+    k Keeper
+}
+
+var _ epochstypes.EpochHooks = Hooks{}
+
+// Hooks returns the x/epochs hooks of the module.
+func (k Keeper) Hooks() Hooks {
+    return Hooks{k}
+}
+
+// AfterEpochEnd snapshots the market stats at the end of every stats epoch.
+func (h Hooks) AfterEpochEnd(ctx context.Context, epochIdentifier string, epochNumber int64) error {
+    params, err := h.k.Params.Get(ctx)
+    if err != nil {
+        return err
+    }
+    if params.StatsEpochIdentifier == "" || epochIdentifier != params.StatsEpochIdentifier {
+        return nil
+    }
+    return h.k.SnapshotMarketStats(ctx, epochNumber)
+}
+
+// BeforeEpochStart implements epochstypes.EpochHooks.
+func (h Hooks) BeforeEpochStart(_ context.Context, _ string, _ int64) error {
+    return nil
+}
@@ -0,0 +1,182 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"amp/x/amp/keeper"
+	"amp/x/amp/types"
+)
+
+func TestMarketStats(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.CommissionRate = sdkmath.LegacyNewDecWithPrec(1, 1)
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	seller := sdk.AccAddress("seller______________")
+	buyer := sdk.AccAddress("buyer_______________")
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 100)))
+	f.bankKeeper.fund(buyer, sdk.NewCoins(sdk.NewInt64Coin("stake", 10000)))
+
+	sell := func(amount, price int64) {
+		asset := sdk.NewCoins(sdk.NewInt64Coin("token", amount))
+		id, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: asset, Price: sdk.NewCoins(sdk.NewInt64Coin("stake", price))})
+		require.NoError(t, err)
+		require.NoError(t, f.keeper.BuyItem(ctx, buyer, id, types.BuyOptions{}))
+	}
+
+	res, err := qs.MarketStats(ctx, &types.QueryMarketStatsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Stats.Denoms)
+	require.Empty(t, res.Stats.Assets)
+
+	// unit prices of 100, 300 and 100stake per token
+	sell(10, 1000)
+	sell(5, 1500)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	sell(4, 400)
+
+	res, err = qs.MarketStats(ctx, &types.QueryMarketStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.DenomStats{{Denom: "stake", Volume: sdkmath.NewInt(2900), Sales: 3, Fees: sdkmath.NewInt(290)}}, res.Stats.Denoms)
+	require.Equal(t, []types.AssetStats{{
+		AssetDenom:  "token",
+		LastPrice:   sdk.NewInt64DecCoin("stake", 100),
+		AllTimeHigh: sdk.NewInt64DecCoin("stake", 300),
+		LastSaleAt:  ctx.BlockTime().Unix(),
+	}}, res.Stats.Assets)
+
+	// only the end of the stats epoch takes a snapshot
+	hooks := f.keeper.Hooks()
+	require.NoError(t, hooks.AfterEpochEnd(ctx, "week", 1))
+	_, err = qs.MarketStats(ctx, &types.QueryMarketStatsRequest{EpochNumber: 1})
+	require.Error(t, err)
+	require.NoError(t, hooks.AfterEpochEnd(ctx, types.DefaultStatsEpochIdentifier, 1))
+
+	sell(1, 50)
+	snapshot, err := qs.MarketStats(ctx, &types.QueryMarketStatsRequest{EpochNumber: 1})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), snapshot.Stats.EpochNumber)
+	require.Equal(t, ctx.BlockTime().Unix(), snapshot.Stats.Time)
+	require.Equal(t, uint64(3), snapshot.Stats.Denoms[0].Sales)
+
+	res, err = qs.MarketStats(ctx, &types.QueryMarketStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), res.Stats.Denoms[0].Sales)
+	require.Equal(t, sdk.NewInt64DecCoin("stake", 50), res.Stats.Assets[0].LastPrice)
+
+	_, err = qs.MarketStats(ctx, &types.QueryMarketStatsRequest{EpochNumber: -1})
+	require.Error(t, err)
+
+	// snapshots are disabled without a stats epoch
+	params.StatsEpochIdentifier = ""
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	require.NoError(t, hooks.AfterEpochEnd(ctx, "", 2))
+	_, err = qs.MarketStats(ctx, &types.QueryMarketStatsRequest{EpochNumber: 2})
+	require.Error(t, err)
+}
+
+func TestMarketStatsCountSettledEscrowSales(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+
+	params := types.DefaultParams()
+	params.CommissionRate = sdkmath.LegacyNewDecWithPrec(1, 1)
+	params.DeliveryTimeout = 500
+	params.DisputeDeposit = nil
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	seller := sdk.AccAddress("seller______________")
+	buyer := sdk.AccAddress("buyer_______________")
+	arbiter := sdk.AccAddress("arbiter_____________")
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 30)))
+	f.bankKeeper.fund(buyer, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)))
+	require.NoError(t, f.keeper.UpdateArbiters(ctx, []string{arbiter.String()}, nil))
+
+	buy := func(price int64) uint64 {
+		id, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", price)), DeliveryEscrow: true})
+		require.NoError(t, err)
+		require.NoError(t, f.keeper.BuyItem(ctx, buyer, id, types.BuyOptions{}))
+		return id
+	}
+	stats := func() types.MarketStats {
+		stats, err := f.keeper.GetMarketStats(ctx)
+		require.NoError(t, err)
+		return stats
+	}
+
+	// a sale awaiting delivery counts once it is released
+	released := buy(100)
+	require.Empty(t, stats().Denoms)
+	require.NoError(t, f.keeper.ConfirmDelivery(ctx, buyer, released))
+	require.Equal(t, []types.DenomStats{{Denom: "stake", Volume: sdkmath.NewInt(100), Sales: 1, Fees: sdkmath.NewInt(10)}}, stats().Denoms)
+	require.Equal(t, sdk.NewInt64DecCoin("stake", 10), stats().Assets[0].LastPrice)
+
+	// a disputed sale counts with what the seller side kept, without a unit price
+	split := buy(300)
+	require.NoError(t, f.keeper.OpenDispute(ctx, buyer, split, "damaged", ""))
+	require.NoError(t, f.keeper.ResolveDispute(ctx, arbiter, split, sdkmath.LegacyNewDecWithPrec(5, 1)))
+	require.Equal(t, []types.DenomStats{{Denom: "stake", Volume: sdkmath.NewInt(250), Sales: 2, Fees: sdkmath.NewInt(25)}}, stats().Denoms)
+	require.Equal(t, sdk.NewInt64DecCoin("stake", 10), stats().Assets[0].LastPrice)
+
+	// a refunded sale does not count at all
+	refunded := buy(200)
+	require.NoError(t, f.keeper.OpenDispute(ctx, buyer, refunded, "not delivered", ""))
+	require.NoError(t, f.keeper.ResolveDispute(ctx, arbiter, refunded, sdkmath.LegacyOneDec()))
+	require.Equal(t, uint64(2), stats().Denoms[0].Sales)
+	require.Equal(t, int64(250), stats().Denoms[0].Volume.Int64())
+}
+
+func TestMarketStatsCountAuctionsAndOrderFillsAndPruneSnapshots(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1000, 0))
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.CommissionRate = sdkmath.LegacyNewDecWithPrec(1, 1)
+	params.StatsSnapshotRetention = 2
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	seller := sdk.AccAddress("seller______________")
+	buyer := sdk.AccAddress("buyer_______________")
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 20)))
+	f.bankKeeper.fund(buyer, sdk.NewCoins(sdk.NewInt64Coin("stake", 200)))
+
+	// an auction settles 10token for 100stake
+	id, err := f.keeper.CreateAuction(ctx, seller, "t", "d", sdk.NewInt64Coin("token", 10), sdk.NewInt64Coin("stake", 100), 2000)
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.PlaceBid(ctx, buyer, id, sdk.NewInt64Coin("stake", 100)))
+	require.NoError(t, f.keeper.SettleAuction(ctx.WithBlockTime(time.Unix(2000, 0)), id))
+
+	// an order book fill trades 10token at 5stake each
+	_, _, err = f.keeper.PlaceOrder(ctx, seller, types.OrderSide_ORDER_SIDE_SELL, "token", sdk.NewInt64Coin("stake", 5), sdkmath.NewInt(10))
+	require.NoError(t, err)
+	_, _, err = f.keeper.PlaceOrder(ctx, buyer, types.OrderSide_ORDER_SIDE_BUY, "token", sdk.NewInt64Coin("stake", 5), sdkmath.NewInt(10))
+	require.NoError(t, err)
+
+	res, err := qs.MarketStats(ctx, &types.QueryMarketStatsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.DenomStats{{Denom: "stake", Volume: sdkmath.NewInt(150), Sales: 2, Fees: sdkmath.NewInt(15)}}, res.Stats.Denoms)
+	require.Equal(t, sdk.NewInt64DecCoin("stake", 5), res.Stats.Assets[0].LastPrice)
+	require.Equal(t, sdk.NewInt64DecCoin("stake", 10), res.Stats.Assets[0].AllTimeHigh)
+
+	// only the latest two snapshots are kept
+	hooks := f.keeper.Hooks()
+	for epoch := int64(1); epoch <= 3; epoch++ {
+		require.NoError(t, hooks.AfterEpochEnd(ctx, types.DefaultStatsEpochIdentifier, epoch))
+	}
+	_, err = qs.MarketStats(ctx, &types.QueryMarketStatsRequest{EpochNumber: 1})
+	require.Error(t, err)
+	for _, epoch := range []int64{2, 3} {
+		_, err = qs.MarketStats(ctx, &types.QueryMarketStatsRequest{EpochNumber: epoch})
+		require.NoError(t, err)
+	}
+}
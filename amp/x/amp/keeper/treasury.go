@@ -0,0 +1,82 @@
+package keeper
+
+import (
+    "context"
+
+    errorsmod "cosmossdk.io/errors"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+    sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+    authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+    "amp/x/amp/types"
+)
+
+// routeFee sends a commission from payer along Params.FeeSplit: shares to the community
+// pool, to be burned and to the treasury, and the rest to the fee collector.
+func (k Keeper) routeFee(ctx context.Context, payer sdk.AccAddress, fee sdk.Coin) error {
+    if !fee.IsPositive() {
+        return nil
+    }
+    params, err := k.Params.Get(ctx)
+    if err != nil {
+        return err
+    }
+    communityPool, burn, treasury, feeCollector := params.FeeSplit.Split(fee)
+
+    if communityPool.IsPositive() {
+        if err := k.distrKeeper.FundCommunityPool(ctx, sdk.NewCoins(communityPool), payer); err != nil {
+            return err
+        }
+    }
+    if burn.IsPositive() {
+        if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, sdk.NewCoins(burn)); err != nil {
+            return err
+        }
+        if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(burn)); err != nil {
+            return err
+        }
+    }
+    if treasury.IsPositive() {
+        if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.TreasuryModuleName, sdk.NewCoins(treasury)); err != nil {
+            return err
+        }
+    }
+    if feeCollector.IsPositive() {
+        if err := k.bankKeeper.SendCoins(ctx, payer, authtypes.NewModuleAddress(authtypes.FeeCollectorName), sdk.NewCoins(feeCollector)); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+// TreasuryBalance returns the address and balance of the marketplace treasury.
+func (k Keeper) TreasuryBalance(ctx context.Context) (sdk.AccAddress, sdk.Coins) {
+    treasury := authtypes.NewModuleAddress(types.TreasuryModuleName)
+    return treasury, k.bankKeeper.SpendableCoins(ctx, treasury)
+}
+
+// SpendTreasury sends amount from the marketplace treasury to recipient. Only the module
+// authority may call it, through MsgSpendTreasury.
+func (k Keeper) SpendTreasury(ctx context.Context, recipient sdk.AccAddress, amount sdk.Coins) error {
+    if !amount.IsValid() || amount.IsZero() {
+        return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s", amount)
+    }
+    if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.TreasuryModuleName, recipient, amount); err != nil {
+        return err
+    }
+
+    recipientStr, _ := k.addressCodec.BytesToString(recipient)
+    sdkCtx := sdk.UnwrapSDKContext(ctx)
+    _ = sdkCtx.EventManager().EmitTypedEvent(&types.EventTreasurySpent{
+        Recipient: recipientStr,
+        Amount:    amount,
+    })
+    sdkCtx.EventManager().EmitEvent(
+        sdk.NewEvent(
+            types.EventTypeTreasurySpent,
+            sdk.NewAttribute(types.AttributeKeyRecipient, recipientStr),
+            sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+        ),
+    )
+    return nil
+}
@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"amp/x/amp/keeper"
+	"amp/x/amp/types"
+)
+
+func TestFeeSplitAndTreasury(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.CommissionRate = sdkmath.LegacyNewDecWithPrec(1, 1)
+	params.FeeSplit = types.FeeSplit{
+		CommunityPool: sdkmath.LegacyNewDecWithPrec(25, 2),
+		Burn:          sdkmath.LegacyNewDecWithPrec(25, 2),
+		Treasury:      sdkmath.LegacyNewDecWithPrec(35, 2),
+	}
+	require.NoError(t, params.Validate())
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	seller := sdk.AccAddress("seller______________")
+	buyer := sdk.AccAddress("buyer_______________")
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 10)))
+	f.bankKeeper.fund(buyer, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)))
+
+	id, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: sdk.NewCoins(sdk.NewInt64Coin("token", 10)), Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.BuyItem(ctx, buyer, id, types.BuyOptions{}))
+
+	// a 100stake fee: 25 to the community pool, 25 burned, 35 to the treasury and the rest
+	// to the fee collector
+	require.Equal(t, int64(900), f.bankKeeper.balance(seller, "stake").Int64())
+	require.Equal(t, int64(25), f.distrKeeper.communityPool.AmountOf("stake").Int64())
+	require.Equal(t, int64(25), f.bankKeeper.burned.AmountOf("stake").Int64())
+	require.True(t, f.bankKeeper.balance(authtypes.NewModuleAddress(types.ModuleName), "stake").IsZero())
+	require.Equal(t, int64(15), f.bankKeeper.balance(authtypes.NewModuleAddress(authtypes.FeeCollectorName), "stake").Int64())
+
+	treasury, err := qs.Treasury(ctx, &types.QueryTreasuryRequest{})
+	require.NoError(t, err)
+	require.Equal(t, authtypes.NewModuleAddress(types.TreasuryModuleName).String(), treasury.Address)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 35)), treasury.Balance)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	recipient, err := f.addressCodec.BytesToString(seller)
+	require.NoError(t, err)
+
+	_, err = ms.SpendTreasury(ctx, &types.MsgSpendTreasury{Authority: recipient, Recipient: recipient, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = ms.SpendTreasury(ctx, &types.MsgSpendTreasury{Authority: authority, Recipient: recipient, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 36))})
+	require.Error(t, err)
+	_, err = ms.SpendTreasury(ctx, &types.MsgSpendTreasury{Authority: authority, Recipient: recipient, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 10))})
+	require.NoError(t, err)
+	require.Equal(t, int64(910), f.bankKeeper.balance(seller, "stake").Int64())
+
+	treasury, err = qs.Treasury(ctx, &types.QueryTreasuryRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 25)), treasury.Balance)
+
+	params.FeeSplit.Treasury = sdkmath.LegacyNewDecWithPrec(6, 1)
+	require.ErrorIs(t, params.Validate(), types.ErrInvalidFeeSplit)
+}
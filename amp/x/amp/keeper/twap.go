@@ -0,0 +1,124 @@
+package keeper
+
+import (
+    "context"
+    "math"
+    "time"
+
+    "cosmossdk.io/collections"
+    errorsmod "cosmossdk.io/errors"
+    sdkmath "cosmossdk.io/math"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+
+    "amp/x/amp/types"
+)
+
+// accumulatePrice adds a sale of quantity of assetDenom at unitPrice in priceDenom to the
+// pair's price accumulator. Several sales in the same second keep one record with their
+// prices weighted by quantity, so that a small sale cannot set the price of a second on
+// its own. Records no TWAP window can reach anymore are pruned.
+func (k Keeper) accumulatePrice(ctx context.Context, assetDenom, priceDenom string, unitPrice sdkmath.LegacyDec, quantity sdkmath.Int) error {
+    now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+    record := types.TwapRecord{Price: unitPrice, Cumulative: sdkmath.LegacyZeroDec(), Time: now, Quantity: quantity}
+    last, found, err := k.twapRecordAt(ctx, assetDenom, priceDenom, now)
+    if err != nil {
+        return err
+    }
+    switch {
+    case found && last.Time == now && !last.Quantity.IsNil():
+        record.Quantity = last.Quantity.Add(quantity)
+        record.Price = last.Price.MulInt(last.Quantity).Add(unitPrice.MulInt(quantity)).QuoInt(record.Quantity)
+        record.Cumulative = last.Cumulative
+    case found:
+        record.Cumulative = last.CumulativeAt(now)
+    }
+    if err := k.TwapRecords.Set(ctx, collections.Join3(assetDenom, priceDenom, now), record); err != nil {
+        return err
+    }
+
+    params, err := k.Params.Get(ctx)
+    if err != nil {
+        return err
+    }
+    if params.MaxTwapWindow == 0 {
+        return nil
+    }
+    return k.pruneTwapRecords(ctx, assetDenom, priceDenom, now-int64(params.MaxTwapWindow))
+}
+
+// pruneTwapRecords removes the records of a pair from before cutoff, except the last one,
+// which still gives the accumulator at cutoff.
+func (k Keeper) pruneTwapRecords(ctx context.Context, assetDenom, priceDenom string, cutoff int64) error {
+    rng := new(collections.Range[collections.Triple[string, string, int64]]).
+        StartInclusive(collections.Join3(assetDenom, priceDenom, int64(math.MinInt64))).
+        EndInclusive(collections.Join3(assetDenom, priceDenom, cutoff))
+    keys, err := k.TwapRecords.Iterate(ctx, rng)
+    if err != nil {
+        return err
+    }
+    stale, err := keys.Keys()
+    if err != nil {
+        return err
+    }
+    for i := 0; i < len(stale)-1; i++ {
+        if err := k.TwapRecords.Remove(ctx, stale[i]); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+// twapRecordAt returns the last record of a pair at or before t, and whether there is one.
+func (k Keeper) twapRecordAt(ctx context.Context, assetDenom, priceDenom string, t int64) (types.TwapRecord, bool, error) {
+    rng := new(collections.Range[collections.Triple[string, string, int64]]).
+        StartInclusive(collections.Join3(assetDenom, priceDenom, int64(math.MinInt64))).
+        EndInclusive(collections.Join3(assetDenom, priceDenom, t)).
+        Descending()
+    it, err := k.TwapRecords.Iterate(ctx, rng)
+    if err != nil {
+        return types.TwapRecord{}, false, err
+    }
+    defer it.Close()
+    if !it.Valid() {
+        return types.TwapRecord{}, false, nil
+    }
+    record, err := it.Value()
+    if err != nil {
+        return types.TwapRecord{}, false, err
+    }
+    return record, true, nil
+}
+
+// GetTWAP returns the time-weighted average unit price assetDenom sold at in priceDenom over
+// the window up to the current block time, at a resolution of seconds. Between sales the
+// quantity-weighted price of the last second with settled sales holds. It fails with
+// ErrInvalidTWAPWindow for windows under a second or over the MaxTwapWindow param, and with
+// ErrNoPriceHistory if the pair had no sale before the window started.
+func (k Keeper) GetTWAP(ctx context.Context, assetDenom, priceDenom string, window time.Duration) (sdkmath.LegacyDec, error) {
+    params, err := k.Params.Get(ctx)
+    if err != nil {
+        return sdkmath.LegacyDec{}, err
+    }
+    seconds := int64(window / time.Second)
+    if seconds <= 0 {
+        return sdkmath.LegacyDec{}, errorsmod.Wrap(types.ErrInvalidTWAPWindow, "window must be at least a second")
+    }
+    if params.MaxTwapWindow > 0 && uint64(seconds) > params.MaxTwapWindow {
+        return sdkmath.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidTWAPWindow, "window must be at most %d seconds", params.MaxTwapWindow)
+    }
+
+    now := sdk.UnwrapSDKContext(ctx).BlockTime().Unix()
+    start := now - seconds
+    first, found, err := k.twapRecordAt(ctx, assetDenom, priceDenom, start)
+    if err != nil {
+        return sdkmath.LegacyDec{}, err
+    }
+    if !found {
+        return sdkmath.LegacyDec{}, errorsmod.Wrapf(types.ErrNoPriceHistory, "no sale of %s in %s before the window", assetDenom, priceDenom)
+    }
+    last, _, err := k.twapRecordAt(ctx, assetDenom, priceDenom, now)
+    if err != nil {
+        return sdkmath.LegacyDec{}, err
+    }
+    return last.CumulativeAt(now).Sub(first.CumulativeAt(start)).QuoInt64(seconds), nil
+}
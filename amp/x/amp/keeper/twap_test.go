@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"amp/x/amp/keeper"
+	"amp/x/amp/types"
+)
+
+func TestTWAP(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	seller := sdk.AccAddress("seller______________")
+	buyer := sdk.AccAddress("buyer_______________")
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 100)))
+	f.bankKeeper.fund(buyer, sdk.NewCoins(sdk.NewInt64Coin("stake", 100000)))
+
+	sell := func(amount, price int64) {
+		asset := sdk.NewCoins(sdk.NewInt64Coin("token", amount))
+		id, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: asset, Price: sdk.NewCoins(sdk.NewInt64Coin("stake", price))})
+		require.NoError(t, err)
+		require.NoError(t, f.keeper.BuyItem(ctx, buyer, id, types.BuyOptions{}))
+	}
+	advance := func(seconds int64) {
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(seconds) * time.Second))
+	}
+
+	_, err := f.keeper.GetTWAP(ctx, "token", "stake", time.Minute)
+	require.ErrorIs(t, err, types.ErrNoPriceHistory)
+
+	// 100stake per token for 100s, then 200stake per token for 100s
+	sell(10, 1000)
+	advance(100)
+	sell(2, 400)
+	sell(5, 1000)
+	advance(100)
+
+	twap, err := f.keeper.GetTWAP(ctx, "token", "stake", 200*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(150), twap)
+	twap, err = f.keeper.GetTWAP(ctx, "token", "stake", 50*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(200), twap)
+
+	res, err := qs.TWAP(ctx, &types.QueryTWAPRequest{AssetDenom: "token", PriceDenom: "stake", Window: 200})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64DecCoin("stake", 150), res.Twap)
+
+	// the window may not start before the first sale, nor exceed the cap
+	_, err = f.keeper.GetTWAP(ctx, "token", "stake", 201*time.Second)
+	require.ErrorIs(t, err, types.ErrNoPriceHistory)
+	_, err = qs.TWAP(ctx, &types.QueryTWAPRequest{AssetDenom: "token", PriceDenom: "stake", Window: 201})
+	require.Error(t, err)
+	_, err = f.keeper.GetTWAP(ctx, "token", "stake", time.Millisecond)
+	require.ErrorIs(t, err, types.ErrInvalidTWAPWindow)
+	_, err = f.keeper.GetTWAP(ctx, "token", "stake", time.Duration(types.DefaultMaxTWAPWindow+1)*time.Second)
+	require.ErrorIs(t, err, types.ErrInvalidTWAPWindow)
+	_, err = qs.TWAP(ctx, &types.QueryTWAPRequest{AssetDenom: "token", PriceDenom: "stake"})
+	require.Error(t, err)
+	_, err = f.keeper.GetTWAP(ctx, "token", "uatom", time.Minute)
+	require.ErrorIs(t, err, types.ErrNoPriceHistory)
+
+	// records beyond the max window are pruned, keeping the one the window starts in
+	params := types.DefaultParams()
+	params.MaxTwapWindow = 150
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	advance(100)
+	sell(1, 300)
+	var times []int64
+	err = f.keeper.TwapRecords.Walk(ctx, nil, func(key collections.Triple[string, string, int64], _ types.TwapRecord) (bool, error) {
+		times = append(times, key.K3())
+		return false, nil
+	})
+	require.NoError(t, err)
+	require.Len(t, times, 2)
+	twap, err = f.keeper.GetTWAP(ctx, "token", "stake", 150*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(200), twap)
+	advance(100)
+	twap, err = f.keeper.GetTWAP(ctx, "token", "stake", 150*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(800).QuoInt64(3), twap)
+}
+
+func TestTWAPWeightsSalesByQuantityAndWaitsForSettlement(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	seller := sdk.AccAddress("seller______________")
+	buyer := sdk.AccAddress("buyer_______________")
+	f.bankKeeper.fund(seller, sdk.NewCoins(sdk.NewInt64Coin("token", 100)))
+	f.bankKeeper.fund(buyer, sdk.NewCoins(sdk.NewInt64Coin("stake", 100000)))
+
+	buy := func(amount, price int64, deliveryEscrow bool) uint64 {
+		asset := sdk.NewCoins(sdk.NewInt64Coin("token", amount))
+		id, err := f.keeper.ListItem(ctx, seller, types.ListingTerms{Title: "t", Description: "d", Asset: asset, Price: sdk.NewCoins(sdk.NewInt64Coin("stake", price)), DeliveryEscrow: deliveryEscrow})
+		require.NoError(t, err)
+		require.NoError(t, f.keeper.BuyItem(ctx, buyer, id, types.BuyOptions{}))
+		return id
+	}
+	advance := func(seconds int64) {
+		ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(seconds) * time.Second))
+	}
+	twap := func(seconds int64) sdkmath.LegacyDec {
+		twap, err := f.keeper.GetTWAP(ctx, "token", "stake", time.Duration(seconds)*time.Second)
+		require.NoError(t, err)
+		return twap
+	}
+
+	// a 1-unit sale at 1000 after 9 units at 100 in the same second moves the price to 190,
+	// not to 1000
+	buy(9, 900, false)
+	buy(1, 1000, false)
+	advance(10)
+	require.Equal(t, sdkmath.LegacyNewDec(190), twap(10))
+
+	// a sale awaiting delivery only counts once its payment is released
+	escrowed := buy(1, 10000, true)
+	advance(10)
+	require.Equal(t, sdkmath.LegacyNewDec(190), twap(20))
+	require.NoError(t, f.keeper.ConfirmDelivery(ctx, buyer, escrowed))
+	advance(10)
+	require.Equal(t, sdkmath.LegacyNewDec(10000), twap(10))
+}
@@ -17,6 +17,75 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Shows the parameters of the module",
 				},
+				{
+					RpcMethod:      "Listing",
+					Use:            "listing [id]",
+					Short:          "Shows a listing",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "Listings",
+					Use:       "listings",
+					Short:     "Lists all listings, oldest first unless --reverse is set",
+				},
+				{
+					RpcMethod:      "ListingsBySeller",
+					Use:            "listings-by-seller [seller]",
+					Short:          "Lists the listings of a seller",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "seller"}},
+				},
+				{
+					RpcMethod:      "ListingsByBuyer",
+					Use:            "listings-by-buyer [buyer]",
+					Short:          "Lists the listings a buyer bought from",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "buyer"}},
+				},
+				{
+					RpcMethod:      "ListingsByStatus",
+					Use:            "listings-by-status [status]",
+					Short:          "Lists the listings in a status, e.g. LISTING_STATUS_ACTIVE",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "status"}},
+				},
+				{
+					RpcMethod:      "ListingsByPrice",
+					Use:            "listings-by-price [asset-denom] [price-denom]",
+					Short:          "Lists the open listings of an asset in a price denom, cheapest first unless --reverse is set",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "asset_denom"}, {ProtoField: "price_denom"}},
+				},
+				{
+					RpcMethod:      "FloorPrice",
+					Use:            "floor-price [asset-denom] [price-denom]",
+					Short:          "Shows the cheapest unit price of an asset among open listings in a price denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "asset_denom"}, {ProtoField: "price_denom"}},
+				},
+				{
+					RpcMethod:      "TWAP",
+					Use:            "twap [asset-denom] [price-denom] [window]",
+					Short:          "Shows the time-weighted average unit price of an asset in a price denom over the last window seconds",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "asset_denom"}, {ProtoField: "price_denom"}, {ProtoField: "window"}},
+				},
+				{
+					RpcMethod: "Categories",
+					Use:       "categories",
+					Short:     "Lists the listing categories",
+				},
+				{
+					RpcMethod:      "ListingsByCategory",
+					Use:            "listings-by-category [category]",
+					Short:          "Lists the open listings in a category",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "category"}},
+				},
+				{
+					RpcMethod:      "ListingsByTag",
+					Use:            "listings-by-tag [tag]",
+					Short:          "Lists the open listings with a tag",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tag"}},
+				},
+				{
+					RpcMethod: "MarketStats",
+					Use:       "market-stats",
+					Short:     "Shows the running market statistics, or their snapshot at the end of --epoch-number",
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
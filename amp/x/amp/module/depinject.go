@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/depinject/appconfig"
 	"github.com/cosmos/cosmos-sdk/codec"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
 
 	"amp/x/amp/keeper"
 	"amp/x/amp/types"
@@ -33,15 +34,18 @@ type ModuleInputs struct {
 	Cdc          codec.Codec
 	AddressCodec address.Codec
 
-	AuthKeeper types.AuthKeeper
-	BankKeeper types.BankKeeper
+	AuthKeeper  types.AuthKeeper
+	BankKeeper  types.BankKeeper
+	NFTKeeper   types.NFTKeeper
+	DistrKeeper types.DistrKeeper
 }
 
 type ModuleOutputs struct {
 	depinject.Out
 
-	AmpKeeper keeper.Keeper
-	Module    appmodule.AppModule
+	AmpKeeper  keeper.Keeper
+	Module     appmodule.AppModule
+	EpochHooks epochstypes.EpochHooksWrapper
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
@@ -56,8 +60,10 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
         in.AddressCodec,
         authority,
         in.BankKeeper,
+        in.NFTKeeper,
+        in.DistrKeeper,
     )
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
-	return ModuleOutputs{AmpKeeper: k, Module: m}
+	return ModuleOutputs{AmpKeeper: k, Module: m, EpochHooks: epochstypes.EpochHooksWrapper{EpochHooks: k.Hooks()}}
 }
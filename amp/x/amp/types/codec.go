@@ -12,6 +12,25 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
         &MsgListItem{},
         &MsgBuyItem{},
         &MsgDelistItem{},
+        &MsgUpdateListing{},
+        &MsgCreateAuction{},
+        &MsgPlaceBid{},
+        &MsgMakeOffer{},
+        &MsgAcceptOffer{},
+        &MsgCancelOffer{},
+        &MsgConfirmDelivery{},
+        &MsgOpenDispute{},
+        &MsgResolveDispute{},
+        &MsgUpdateArbiters{},
+        &MsgBatchBuy{},
+        &MsgBatchList{},
+        &MsgPlaceOrder{},
+        &MsgCancelOrder{},
+        &MsgSetRoyalty{},
+        &MsgSetCreator{},
+        &MsgSpendTreasury{},
+        &MsgTakedownListing{},
+        &MsgUpdateCategories{},
     )
     msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
 }
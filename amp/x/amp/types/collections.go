@@ -0,0 +1,73 @@
+package types
+
+import (
+    "encoding/json"
+    "fmt"
+    "math/big"
+
+    collcodec "cosmossdk.io/collections/codec"
+    sdkmath "cosmossdk.io/math"
+)
+
+// legacyDecKeySize is the number of bytes a LegacyDecKey takes, enough for the integer
+// behind any valid LegacyDec.
+const legacyDecKeySize = 40
+
+// LegacyDecKey is a collections key codec for non-negative sdkmath.LegacyDec values that
+// sorts keys by value: the decimal's underlying integer is stored big-endian in a fixed
+// number of bytes, so the encoding is the same in terminal and non-terminal position.
+var LegacyDecKey collcodec.KeyCodec[sdkmath.LegacyDec] = legacyDecKey{}
+
+type legacyDecKey struct{}
+
+func (legacyDecKey) Encode(buffer []byte, key sdkmath.LegacyDec) (int, error) {
+    if key.IsNil() || key.IsNegative() {
+        return 0, fmt.Errorf("%w: decimal key must not be nil or negative", collcodec.ErrEncoding)
+    }
+    bz := key.BigInt().Bytes()
+    if len(bz) > legacyDecKeySize {
+        return 0, fmt.Errorf("%w: decimal key %s out of range", collcodec.ErrEncoding, key)
+    }
+    if len(buffer) < legacyDecKeySize {
+        return 0, fmt.Errorf("%w: buffer too small", collcodec.ErrEncoding)
+    }
+    clear(buffer[:legacyDecKeySize-len(bz)])
+    copy(buffer[legacyDecKeySize-len(bz):], bz)
+    return legacyDecKeySize, nil
+}
+
+func (legacyDecKey) Decode(buffer []byte) (int, sdkmath.LegacyDec, error) {
+    if len(buffer) < legacyDecKeySize {
+        return 0, sdkmath.LegacyDec{}, fmt.Errorf("%w: invalid buffer size, wanted %d, got %d", collcodec.ErrEncoding, legacyDecKeySize, len(buffer))
+    }
+    i := new(big.Int).SetBytes(buffer[:legacyDecKeySize])
+    return legacyDecKeySize, sdkmath.LegacyNewDecFromBigIntWithPrec(i, sdkmath.LegacyPrecision), nil
+}
+
+func (legacyDecKey) Size(sdkmath.LegacyDec) int { return legacyDecKeySize }
+
+func (legacyDecKey) EncodeJSON(value sdkmath.LegacyDec) ([]byte, error) {
+    return json.Marshal(value.String())
+}
+
+func (legacyDecKey) DecodeJSON(b []byte) (sdkmath.LegacyDec, error) {
+    var s string
+    if err := json.Unmarshal(b, &s); err != nil {
+        return sdkmath.LegacyDec{}, err
+    }
+    return sdkmath.LegacyNewDecFromStr(s)
+}
+
+func (legacyDecKey) Stringify(key sdkmath.LegacyDec) string { return key.String() }
+
+func (legacyDecKey) KeyType() string { return "amp/legacy_dec" }
+
+func (k legacyDecKey) EncodeNonTerminal(buffer []byte, key sdkmath.LegacyDec) (int, error) {
+    return k.Encode(buffer, key)
+}
+
+func (k legacyDecKey) DecodeNonTerminal(buffer []byte) (int, sdkmath.LegacyDec, error) {
+    return k.Decode(buffer)
+}
+
+func (k legacyDecKey) SizeNonTerminal(key sdkmath.LegacyDec) int { return k.Size(key) }
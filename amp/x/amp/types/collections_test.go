@@ -0,0 +1,36 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"cosmossdk.io/collections/colltest"
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"amp/x/amp/types"
+)
+
+func TestLegacyDecKey(t *testing.T) {
+	colltest.TestKeyCodec(t, types.LegacyDecKey, sdkmath.LegacyMustNewDecFromStr("1.5"))
+
+	encode := func(d sdkmath.LegacyDec) []byte {
+		buf := make([]byte, types.LegacyDecKey.Size(d))
+		_, err := types.LegacyDecKey.Encode(buf, d)
+		require.NoError(t, err)
+		return buf
+	}
+	ordered := []sdkmath.LegacyDec{
+		sdkmath.LegacyZeroDec(),
+		sdkmath.LegacyMustNewDecFromStr("0.000000000000000001"),
+		sdkmath.LegacyMustNewDecFromStr("1.5"),
+		sdkmath.LegacyNewDec(2),
+		sdkmath.LegacyNewDec(256),
+	}
+	for i := 1; i < len(ordered); i++ {
+		require.Negative(t, bytes.Compare(encode(ordered[i-1]), encode(ordered[i])), "%s < %s", ordered[i-1], ordered[i])
+	}
+
+	_, err := types.LegacyDecKey.Encode(make([]byte, 40), sdkmath.LegacyNewDec(-1))
+	require.Error(t, err)
+}
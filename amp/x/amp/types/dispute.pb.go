@@ -0,0 +1,2140 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: amp/amp/v1/dispute.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DisputeStatus represents the state of a dispute
+type DisputeStatus int32
+
+const (
+	DisputeStatus_DISPUTE_STATUS_OPEN     DisputeStatus = 0
+	DisputeStatus_DISPUTE_STATUS_RESOLVED DisputeStatus = 1
+)
+
+var DisputeStatus_name = map[int32]string{
+	0: "DISPUTE_STATUS_OPEN",
+	1: "DISPUTE_STATUS_RESOLVED",
+}
+
+var DisputeStatus_value = map[string]int32{
+	"DISPUTE_STATUS_OPEN":     0,
+	"DISPUTE_STATUS_RESOLVED": 1,
+}
+
+func (x DisputeStatus) String() string {
+	return proto.EnumName(DisputeStatus_name, int32(x))
+}
+
+func (DisputeStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c76d3bcd7ca82fb0, []int{0}
+}
+
+// Dispute is a buyer's challenge of a sale whose payment is still held in escrow
+type Dispute struct {
+	ListingId   uint64 `protobuf:"varint,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	Buyer       string `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Seller      string `protobuf:"bytes,3,opt,name=seller,proto3" json:"seller,omitempty"`
+	Reason      string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	EvidenceUri string `protobuf:"bytes,5,opt,name=evidence_uri,json=evidenceUri,proto3" json:"evidence_uri,omitempty"`
+	// buyer_deposit is locked by the buyer on open and forfeited to the seller if the buyer is
+	// awarded less than half
+	BuyerDeposit types.Coin    `protobuf:"bytes,6,opt,name=buyer_deposit,json=buyerDeposit,proto3" json:"buyer_deposit"`
+	Status       DisputeStatus `protobuf:"varint,7,opt,name=status,proto3,enum=amp.amp.v1.DisputeStatus" json:"status,omitempty"`
+	OpenedAt     int64         `protobuf:"varint,8,opt,name=opened_at,json=openedAt,proto3" json:"opened_at,omitempty"`
+	Arbiter      string        `protobuf:"bytes,9,opt,name=arbiter,proto3" json:"arbiter,omitempty"`
+	// buyer_ratio is the share of the escrowed payment refunded to the buyer, in [0,1]
+	BuyerRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=buyer_ratio,json=buyerRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"buyer_ratio"`
+	ResolvedAt int64                       `protobuf:"varint,11,opt,name=resolved_at,json=resolvedAt,proto3" json:"resolved_at,omitempty"`
+	// seller_bond is the part of the escrowed payment held on open as the seller's bond and paid
+	// to the buyer out of the seller's share if the buyer is awarded more than half
+	SellerBond types.Coin `protobuf:"bytes,12,opt,name=seller_bond,json=sellerBond,proto3" json:"seller_bond"`
+	Deadline   int64      `protobuf:"varint,13,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	TimedOut   bool       `protobuf:"varint,14,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+}
+
+func (m *Dispute) Reset()         { *m = Dispute{} }
+func (m *Dispute) String() string { return proto.CompactTextString(m) }
+func (*Dispute) ProtoMessage()    {}
+func (*Dispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c76d3bcd7ca82fb0, []int{0}
+}
+func (m *Dispute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Dispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Dispute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Dispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Dispute.Merge(m, src)
+}
+func (m *Dispute) XXX_Size() int {
+	return m.Size()
+}
+func (m *Dispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_Dispute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Dispute proto.InternalMessageInfo
+
+func (m *Dispute) GetListingId() uint64 {
+	if m != nil {
+		return m.ListingId
+	}
+	return 0
+}
+
+func (m *Dispute) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *Dispute) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *Dispute) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *Dispute) GetEvidenceUri() string {
+	if m != nil {
+		return m.EvidenceUri
+	}
+	return ""
+}
+
+func (m *Dispute) GetBuyerDeposit() types.Coin {
+	if m != nil {
+		return m.BuyerDeposit
+	}
+	return types.Coin{}
+}
+
+func (m *Dispute) GetStatus() DisputeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return DisputeStatus_DISPUTE_STATUS_OPEN
+}
+
+func (m *Dispute) GetOpenedAt() int64 {
+	if m != nil {
+		return m.OpenedAt
+	}
+	return 0
+}
+
+func (m *Dispute) GetArbiter() string {
+	if m != nil {
+		return m.Arbiter
+	}
+	return ""
+}
+
+func (m *Dispute) GetResolvedAt() int64 {
+	if m != nil {
+		return m.ResolvedAt
+	}
+	return 0
+}
+
+func (m *Dispute) GetSellerBond() types.Coin {
+	if m != nil {
+		return m.SellerBond
+	}
+	return types.Coin{}
+}
+
+func (m *Dispute) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+func (m *Dispute) GetTimedOut() bool {
+	if m != nil {
+		return m.TimedOut
+	}
+	return false
+}
+
+// Event emitted when a buyer opens a dispute
+type EventDisputeOpened struct {
+	ListingId    uint64     `protobuf:"varint,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	Buyer        string     `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Seller       string     `protobuf:"bytes,3,opt,name=seller,proto3" json:"seller,omitempty"`
+	Reason       string     `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	EvidenceUri  string     `protobuf:"bytes,5,opt,name=evidence_uri,json=evidenceUri,proto3" json:"evidence_uri,omitempty"`
+	BuyerDeposit types.Coin `protobuf:"bytes,6,opt,name=buyer_deposit,json=buyerDeposit,proto3" json:"buyer_deposit"`
+	SellerBond   types.Coin `protobuf:"bytes,7,opt,name=seller_bond,json=sellerBond,proto3" json:"seller_bond"`
+	Deadline     int64      `protobuf:"varint,8,opt,name=deadline,proto3" json:"deadline,omitempty"`
+}
+
+func (m *EventDisputeOpened) Reset()         { *m = EventDisputeOpened{} }
+func (m *EventDisputeOpened) String() string { return proto.CompactTextString(m) }
+func (*EventDisputeOpened) ProtoMessage()    {}
+func (*EventDisputeOpened) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c76d3bcd7ca82fb0, []int{1}
+}
+func (m *EventDisputeOpened) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDisputeOpened) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDisputeOpened.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDisputeOpened) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDisputeOpened.Merge(m, src)
+}
+func (m *EventDisputeOpened) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDisputeOpened) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDisputeOpened.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDisputeOpened proto.InternalMessageInfo
+
+func (m *EventDisputeOpened) GetListingId() uint64 {
+	if m != nil {
+		return m.ListingId
+	}
+	return 0
+}
+
+func (m *EventDisputeOpened) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *EventDisputeOpened) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *EventDisputeOpened) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *EventDisputeOpened) GetEvidenceUri() string {
+	if m != nil {
+		return m.EvidenceUri
+	}
+	return ""
+}
+
+func (m *EventDisputeOpened) GetBuyerDeposit() types.Coin {
+	if m != nil {
+		return m.BuyerDeposit
+	}
+	return types.Coin{}
+}
+
+func (m *EventDisputeOpened) GetSellerBond() types.Coin {
+	if m != nil {
+		return m.SellerBond
+	}
+	return types.Coin{}
+}
+
+func (m *EventDisputeOpened) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+// Event emitted when an arbiter resolves a dispute, or when it times out
+type EventDisputeResolved struct {
+	ListingId           uint64                      `protobuf:"varint,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	Arbiter             string                      `protobuf:"bytes,2,opt,name=arbiter,proto3" json:"arbiter,omitempty"`
+	BuyerRatio          cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=buyer_ratio,json=buyerRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"buyer_ratio"`
+	BuyerAmount         types.Coin                  `protobuf:"bytes,4,opt,name=buyer_amount,json=buyerAmount,proto3" json:"buyer_amount"`
+	SellerAmount        types.Coin                  `protobuf:"bytes,5,opt,name=seller_amount,json=sellerAmount,proto3" json:"seller_amount"`
+	Fee                 types.Coin                  `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee"`
+	DepositRecipient    string                      `protobuf:"bytes,7,opt,name=deposit_recipient,json=depositRecipient,proto3" json:"deposit_recipient,omitempty"`
+	Royalties           []RoyaltyPayment            `protobuf:"bytes,8,rep,name=royalties,proto3" json:"royalties"`
+	Referrals           []ReferralPayment           `protobuf:"bytes,9,rep,name=referrals,proto3" json:"referrals"`
+	SellerBondRecipient string                      `protobuf:"bytes,10,opt,name=seller_bond_recipient,json=sellerBondRecipient,proto3" json:"seller_bond_recipient,omitempty"`
+	TimedOut            bool                        `protobuf:"varint,11,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+}
+
+func (m *EventDisputeResolved) Reset()         { *m = EventDisputeResolved{} }
+func (m *EventDisputeResolved) String() string { return proto.CompactTextString(m) }
+func (*EventDisputeResolved) ProtoMessage()    {}
+func (*EventDisputeResolved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c76d3bcd7ca82fb0, []int{2}
+}
+func (m *EventDisputeResolved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDisputeResolved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDisputeResolved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDisputeResolved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDisputeResolved.Merge(m, src)
+}
+func (m *EventDisputeResolved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDisputeResolved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDisputeResolved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDisputeResolved proto.InternalMessageInfo
+
+func (m *EventDisputeResolved) GetListingId() uint64 {
+	if m != nil {
+		return m.ListingId
+	}
+	return 0
+}
+
+func (m *EventDisputeResolved) GetArbiter() string {
+	if m != nil {
+		return m.Arbiter
+	}
+	return ""
+}
+
+func (m *EventDisputeResolved) GetBuyerAmount() types.Coin {
+	if m != nil {
+		return m.BuyerAmount
+	}
+	return types.Coin{}
+}
+
+func (m *EventDisputeResolved) GetSellerAmount() types.Coin {
+	if m != nil {
+		return m.SellerAmount
+	}
+	return types.Coin{}
+}
+
+func (m *EventDisputeResolved) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
+func (m *EventDisputeResolved) GetDepositRecipient() string {
+	if m != nil {
+		return m.DepositRecipient
+	}
+	return ""
+}
+
+func (m *EventDisputeResolved) GetRoyalties() []RoyaltyPayment {
+	if m != nil {
+		return m.Royalties
+	}
+	return nil
+}
+
+func (m *EventDisputeResolved) GetReferrals() []ReferralPayment {
+	if m != nil {
+		return m.Referrals
+	}
+	return nil
+}
+
+func (m *EventDisputeResolved) GetSellerBondRecipient() string {
+	if m != nil {
+		return m.SellerBondRecipient
+	}
+	return ""
+}
+
+func (m *EventDisputeResolved) GetTimedOut() bool {
+	if m != nil {
+		return m.TimedOut
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("amp.amp.v1.DisputeStatus", DisputeStatus_name, DisputeStatus_value)
+	proto.RegisterType((*Dispute)(nil), "amp.amp.v1.Dispute")
+	proto.RegisterType((*EventDisputeOpened)(nil), "amp.amp.v1.EventDisputeOpened")
+	proto.RegisterType((*EventDisputeResolved)(nil), "amp.amp.v1.EventDisputeResolved")
+}
+
+func init() { proto.RegisterFile("amp/amp/v1/dispute.proto", fileDescriptor_c76d3bcd7ca82fb0) }
+
+var fileDescriptor_c76d3bcd7ca82fb0 = []byte{
+	// 740 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0x41, 0x6f, 0x1a, 0x47,
+	0x14, 0x66, 0x0d, 0x06, 0xf6, 0xad, 0xed, 0xba, 0x63, 0xb7, 0x1e, 0x40, 0xc5, 0xd4, 0x27, 0xda,
+	0xaa, 0x8b, 0xa0, 0xf7, 0xb6, 0x50, 0x38, 0x58, 0xb2, 0x8a, 0xb5, 0xd8, 0x3d, 0xf4, 0xb2, 0x1a,
+	0xd8, 0x67, 0x3a, 0x2a, 0xec, 0xac, 0x76, 0x07, 0x14, 0xfe, 0x45, 0xfe, 0x4a, 0xa4, 0xfc, 0x80,
+	0x1c, 0x7d, 0xb4, 0x72, 0x8a, 0x72, 0x70, 0x22, 0xfb, 0x8f, 0x44, 0x3b, 0x33, 0x08, 0xf0, 0x21,
+	0x41, 0x4a, 0x4e, 0x39, 0xac, 0xc4, 0xfb, 0xbe, 0x79, 0x8f, 0xef, 0x7d, 0xf3, 0xf6, 0x2d, 0x50,
+	0x36, 0x8d, 0x1a, 0xe9, 0x33, 0x6f, 0x36, 0x02, 0x9e, 0x44, 0x33, 0x89, 0x6e, 0x14, 0x0b, 0x29,
+	0x08, 0xb0, 0x69, 0xe4, 0xa6, 0xcf, 0xbc, 0x59, 0x2e, 0xad, 0x9d, 0x8a, 0xf1, 0x06, 0xe3, 0x98,
+	0x4d, 0xf4, 0xb1, 0xf2, 0x7a, 0x81, 0x58, 0x2c, 0xd8, 0x44, 0x2e, 0x0c, 0x53, 0x1d, 0x89, 0x64,
+	0x2a, 0x92, 0xc6, 0x90, 0x25, 0xd8, 0x98, 0x37, 0x87, 0x28, 0x59, 0xb3, 0x31, 0x12, 0x3c, 0x34,
+	0x7c, 0x49, 0xf3, 0xbe, 0x8a, 0x1a, 0x3a, 0x30, 0xd4, 0xf1, 0x58, 0x8c, 0x85, 0xc6, 0xd3, 0x5f,
+	0x1a, 0x3d, 0x7b, 0x91, 0x83, 0x42, 0x57, 0x6b, 0x24, 0x3f, 0x00, 0x4c, 0x78, 0x22, 0x79, 0x38,
+	0xf6, 0x79, 0x40, 0xad, 0x9a, 0x55, 0xcf, 0x79, 0xb6, 0x41, 0xce, 0x03, 0x72, 0x0c, 0xbb, 0xc3,
+	0xd9, 0x02, 0x63, 0xba, 0x53, 0xb3, 0xea, 0xb6, 0xa7, 0x03, 0xf2, 0x3d, 0xe4, 0x13, 0x9c, 0x4c,
+	0x30, 0xa6, 0x59, 0x05, 0x9b, 0x28, 0xc5, 0x63, 0x64, 0x89, 0x08, 0x69, 0x4e, 0xe3, 0x3a, 0x22,
+	0x3f, 0xc2, 0x1e, 0xce, 0x79, 0x80, 0xe1, 0x08, 0xfd, 0x59, 0xcc, 0xe9, 0xae, 0x62, 0x9d, 0x25,
+	0x76, 0x1d, 0x73, 0xd2, 0x85, 0x7d, 0x55, 0xdb, 0x0f, 0x30, 0x12, 0x09, 0x97, 0x34, 0x5f, 0xb3,
+	0xea, 0x4e, 0xab, 0xe4, 0x9a, 0x7e, 0xd2, 0xe6, 0x5d, 0xd3, 0xbc, 0xfb, 0x97, 0xe0, 0x61, 0x27,
+	0x77, 0x7b, 0x7f, 0x9a, 0xf1, 0xf6, 0x54, 0x56, 0x57, 0x27, 0x91, 0x26, 0xe4, 0x13, 0xc9, 0xe4,
+	0x2c, 0xa1, 0x85, 0x9a, 0x55, 0x3f, 0x68, 0x95, 0xdc, 0x95, 0xf9, 0xae, 0x69, 0x79, 0xa0, 0x0e,
+	0x78, 0xe6, 0x20, 0xa9, 0x80, 0x2d, 0x22, 0x0c, 0x31, 0xf0, 0x99, 0xa4, 0xc5, 0x9a, 0x55, 0xcf,
+	0x7a, 0x45, 0x0d, 0xb4, 0x25, 0xa1, 0x50, 0x60, 0xf1, 0x90, 0x4b, 0x8c, 0xa9, 0xad, 0x34, 0x2f,
+	0x43, 0xe2, 0x81, 0xa3, 0xf5, 0xc6, 0x4c, 0x72, 0x41, 0x21, 0x65, 0x3b, 0xcd, 0x54, 0xd2, 0xdb,
+	0xfb, 0xd3, 0x8a, 0x16, 0x9d, 0x04, 0xff, 0xbb, 0x5c, 0x34, 0xa6, 0x4c, 0xfe, 0xe7, 0x5e, 0xe0,
+	0x98, 0x8d, 0x16, 0x5d, 0x1c, 0xbd, 0x7e, 0xf9, 0x2b, 0x98, 0x9e, 0xba, 0x38, 0xf2, 0x40, 0x55,
+	0xf1, 0xd2, 0x22, 0xe4, 0x14, 0x9c, 0x18, 0x13, 0x31, 0x99, 0x6b, 0x31, 0x8e, 0x12, 0x03, 0x4b,
+	0xa8, 0x2d, 0xc9, 0x9f, 0xe0, 0x68, 0xa7, 0xfd, 0xa1, 0x08, 0x03, 0xba, 0xb7, 0x9d, 0x45, 0xa0,
+	0x73, 0x3a, 0x22, 0x0c, 0x48, 0x19, 0x8a, 0x01, 0xb2, 0x60, 0xc2, 0x43, 0xa4, 0xfb, 0xba, 0xd9,
+	0x65, 0x9c, 0x3a, 0x21, 0xf9, 0x14, 0x03, 0x5f, 0xcc, 0x24, 0x3d, 0xa8, 0x59, 0xf5, 0xa2, 0x57,
+	0x54, 0x40, 0x7f, 0x26, 0xcf, 0x5e, 0xed, 0x00, 0xe9, 0xcd, 0x31, 0x94, 0xc6, 0xc5, 0xbe, 0xb2,
+	0xe8, 0x2b, 0x1b, 0x9f, 0x27, 0xfe, 0x16, 0x3e, 0xcf, 0xdf, 0xe2, 0xa6, 0xbf, 0x67, 0xef, 0x72,
+	0x70, 0xbc, 0x6e, 0xa1, 0x67, 0x2e, 0xf6, 0x53, 0x26, 0xae, 0x0d, 0xe1, 0xce, 0x47, 0x87, 0x30,
+	0xfb, 0x25, 0x86, 0xb0, 0x03, 0xda, 0x13, 0x9f, 0x4d, 0xc5, 0x2c, 0x94, 0x34, 0xb7, 0x9d, 0x09,
+	0x5a, 0x48, 0x5b, 0xe5, 0xa4, 0xb7, 0x61, 0x7c, 0x34, 0x45, 0x76, 0xb7, 0xbc, 0x0d, 0x9d, 0x65,
+	0xaa, 0x34, 0x21, 0x7b, 0x83, 0xb8, 0xed, 0x4d, 0xa6, 0x67, 0xc9, 0x2f, 0xf0, 0xad, 0x19, 0x00,
+	0x3f, 0xc6, 0x11, 0x8f, 0x38, 0x86, 0x52, 0x5d, 0xa3, 0xed, 0x1d, 0x1a, 0xc2, 0x5b, 0xe2, 0xe4,
+	0x77, 0xb0, 0xf5, 0xa2, 0xe5, 0x98, 0xd0, 0x62, 0x2d, 0x5b, 0x77, 0x5a, 0xe5, 0xf5, 0x7d, 0xe1,
+	0x29, 0x72, 0x71, 0xc9, 0x16, 0x53, 0x0c, 0xa5, 0xf9, 0x9b, 0x55, 0x0a, 0xf9, 0x03, 0xec, 0xe5,
+	0x0e, 0x4f, 0xa8, 0xad, 0xf2, 0x2b, 0x1b, 0xf9, 0x86, 0x7c, 0x5a, 0x60, 0x99, 0x43, 0x5a, 0xf0,
+	0xdd, 0xda, 0xb8, 0xad, 0x29, 0x56, 0xdb, 0xc4, 0x3b, 0x5a, 0xcd, 0xd5, 0x4a, 0xf4, 0xc6, 0x4b,
+	0xea, 0x6c, 0xbe, 0xa4, 0x3f, 0xf7, 0x60, 0x7f, 0x63, 0xc9, 0x91, 0x13, 0x38, 0xea, 0x9e, 0x0f,
+	0x2e, 0xaf, 0xaf, 0x7a, 0xfe, 0xe0, 0xaa, 0x7d, 0x75, 0x3d, 0xf0, 0xfb, 0x97, 0xbd, 0xbf, 0x0f,
+	0x33, 0xa4, 0x02, 0x27, 0x4f, 0x08, 0xaf, 0x37, 0xe8, 0x5f, 0xfc, 0xd3, 0xeb, 0x1e, 0x5a, 0x9d,
+	0x9f, 0x6e, 0x1f, 0xaa, 0xd6, 0xdd, 0x43, 0xd5, 0x7a, 0xff, 0x50, 0xb5, 0x9e, 0x3f, 0x56, 0x33,
+	0x77, 0x8f, 0xd5, 0xcc, 0x9b, 0xc7, 0x6a, 0xe6, 0xdf, 0x6f, 0xd2, 0x0f, 0xd4, 0x33, 0xf5, 0x99,
+	0x92, 0x8b, 0x08, 0x93, 0x61, 0x5e, 0x7d, 0x51, 0x7e, 0xfb, 0x30, 0x00, 0xf7, 0x40, 0x1b, 0xe1,
+	0xff, 0x06, 0x00, 0x00,
+}
+
+func (m *Dispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Dispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Dispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimedOut {
+		i--
+		if m.TimedOut {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Deadline != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size, err := m.SellerBond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDispute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.ResolvedAt != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.ResolvedAt))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.BuyerRatio.Size()
+		i -= size
+		if _, err := m.BuyerRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDispute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.Arbiter) > 0 {
+		i -= len(m.Arbiter)
+		copy(dAtA[i:], m.Arbiter)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.Arbiter)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.OpenedAt != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.OpenedAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Status != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.BuyerDeposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDispute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.EvidenceUri) > 0 {
+		i -= len(m.EvidenceUri)
+		copy(dAtA[i:], m.EvidenceUri)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.EvidenceUri)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ListingId != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.ListingId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDisputeOpened) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDisputeOpened) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDisputeOpened) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deadline != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.SellerBond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDispute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.BuyerDeposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDispute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.EvidenceUri) > 0 {
+		i -= len(m.EvidenceUri)
+		copy(dAtA[i:], m.EvidenceUri)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.EvidenceUri)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ListingId != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.ListingId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDisputeResolved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDisputeResolved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDisputeResolved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimedOut {
+		i--
+		if m.TimedOut {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.SellerBondRecipient) > 0 {
+		i -= len(m.SellerBondRecipient)
+		copy(dAtA[i:], m.SellerBondRecipient)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.SellerBondRecipient)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Referrals) > 0 {
+		for iNdEx := len(m.Referrals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Referrals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDispute(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Royalties) > 0 {
+		for iNdEx := len(m.Royalties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Royalties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDispute(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.DepositRecipient) > 0 {
+		i -= len(m.DepositRecipient)
+		copy(dAtA[i:], m.DepositRecipient)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.DepositRecipient)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDispute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.SellerAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDispute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.BuyerAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDispute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BuyerRatio.Size()
+		i -= size
+		if _, err := m.BuyerRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDispute(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Arbiter) > 0 {
+		i -= len(m.Arbiter)
+		copy(dAtA[i:], m.Arbiter)
+		i = encodeVarintDispute(dAtA, i, uint64(len(m.Arbiter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ListingId != 0 {
+		i = encodeVarintDispute(dAtA, i, uint64(m.ListingId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDispute(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDispute(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Dispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ListingId != 0 {
+		n += 1 + sovDispute(uint64(m.ListingId))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	l = len(m.EvidenceUri)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	l = m.BuyerDeposit.Size()
+	n += 1 + l + sovDispute(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovDispute(uint64(m.Status))
+	}
+	if m.OpenedAt != 0 {
+		n += 1 + sovDispute(uint64(m.OpenedAt))
+	}
+	l = len(m.Arbiter)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	l = m.BuyerRatio.Size()
+	n += 1 + l + sovDispute(uint64(l))
+	if m.ResolvedAt != 0 {
+		n += 1 + sovDispute(uint64(m.ResolvedAt))
+	}
+	l = m.SellerBond.Size()
+	n += 1 + l + sovDispute(uint64(l))
+	if m.Deadline != 0 {
+		n += 1 + sovDispute(uint64(m.Deadline))
+	}
+	if m.TimedOut {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventDisputeOpened) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ListingId != 0 {
+		n += 1 + sovDispute(uint64(m.ListingId))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	l = len(m.EvidenceUri)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	l = m.BuyerDeposit.Size()
+	n += 1 + l + sovDispute(uint64(l))
+	l = m.SellerBond.Size()
+	n += 1 + l + sovDispute(uint64(l))
+	if m.Deadline != 0 {
+		n += 1 + sovDispute(uint64(m.Deadline))
+	}
+	return n
+}
+
+func (m *EventDisputeResolved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ListingId != 0 {
+		n += 1 + sovDispute(uint64(m.ListingId))
+	}
+	l = len(m.Arbiter)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	l = m.BuyerRatio.Size()
+	n += 1 + l + sovDispute(uint64(l))
+	l = m.BuyerAmount.Size()
+	n += 1 + l + sovDispute(uint64(l))
+	l = m.SellerAmount.Size()
+	n += 1 + l + sovDispute(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovDispute(uint64(l))
+	l = len(m.DepositRecipient)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	if len(m.Royalties) > 0 {
+		for _, e := range m.Royalties {
+			l = e.Size()
+			n += 1 + l + sovDispute(uint64(l))
+		}
+	}
+	if len(m.Referrals) > 0 {
+		for _, e := range m.Referrals {
+			l = e.Size()
+			n += 1 + l + sovDispute(uint64(l))
+		}
+	}
+	l = len(m.SellerBondRecipient)
+	if l > 0 {
+		n += 1 + l + sovDispute(uint64(l))
+	}
+	if m.TimedOut {
+		n += 2
+	}
+	return n
+}
+
+func sovDispute(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDispute(x uint64) (n int) {
+	return sovDispute(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Dispute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDispute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Dispute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Dispute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListingId", wireType)
+			}
+			m.ListingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ListingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BuyerDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DisputeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenedAt", wireType)
+			}
+			m.OpenedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OpenedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbiter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arbiter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BuyerRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResolvedAt", wireType)
+			}
+			m.ResolvedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResolvedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellerBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SellerBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimedOut", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimedOut = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDispute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDisputeOpened) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDispute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDisputeOpened: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDisputeOpened: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListingId", wireType)
+			}
+			m.ListingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ListingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvidenceUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EvidenceUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BuyerDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellerBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SellerBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDispute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDisputeResolved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDispute
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDisputeResolved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDisputeResolved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListingId", wireType)
+			}
+			m.ListingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ListingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Arbiter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Arbiter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BuyerRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BuyerAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellerAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SellerAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DepositRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Royalties = append(m.Royalties, RoyaltyPayment{})
+			if err := m.Royalties[len(m.Royalties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrals = append(m.Referrals, ReferralPayment{})
+			if err := m.Referrals[len(m.Referrals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellerBondRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDispute
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SellerBondRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimedOut", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimedOut = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDispute(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDispute
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDispute(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDispute
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDispute
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDispute
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDispute
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDispute
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDispute        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDispute          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDispute = fmt.Errorf("proto: unexpected end of group")
+)
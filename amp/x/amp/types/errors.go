@@ -13,4 +13,41 @@ var (
     ErrSelfPurchase     = errors.Register(ModuleName, 1102, "seller cannot buy own listing")
     ErrUnauthorized     = errors.Register(ModuleName, 1103, "unauthorized action")
     ErrInvalidCommissionRate = errors.Register(ModuleName, 1104, "commission_rate must be between 0 and 1")
+    ErrAuctionNotActive      = errors.Register(ModuleName, 1105, "auction is not active")
+    ErrAuctionEnded          = errors.Register(ModuleName, 1106, "auction has ended")
+    ErrInvalidEndTime        = errors.Register(ModuleName, 1107, "end time must be after the current block time")
+    ErrBidTooLow             = errors.Register(ModuleName, 1108, "bid is below the minimum or current highest bid")
+    ErrInvalidDutchAuction   = errors.Register(ModuleName, 1109, "invalid declining price configuration")
+    ErrOfferNotOpen          = errors.Register(ModuleName, 1110, "offer is not open")
+    ErrOfferExpired          = errors.Register(ModuleName, 1111, "offer has expired")
+    ErrInvalidExpiry         = errors.Register(ModuleName, 1112, "expiry must be after the current block time")
+    ErrInvalidOffer          = errors.Register(ModuleName, 1113, "invalid offer")
+    ErrListingExpired        = errors.Register(ModuleName, 1114, "listing has expired")
+    ErrInvalidQuantity       = errors.Register(ModuleName, 1115, "invalid quantity")
+    ErrInvalidRestriction    = errors.Register(ModuleName, 1116, "invalid listing restriction")
+    ErrBuyerNotEligible      = errors.Register(ModuleName, 1117, "buyer is not eligible for this listing")
+    ErrInvalidDenom          = errors.Register(ModuleName, 1118, "payment denom is not accepted by this listing")
+    ErrInvalidNFT            = errors.Register(ModuleName, 1119, "invalid NFT listing")
+    ErrNotAwaitingDelivery   = errors.Register(ModuleName, 1120, "listing is not awaiting delivery")
+    ErrNotArbiter            = errors.Register(ModuleName, 1121, "signer is not an appointed arbiter")
+    ErrDisputeNotOpen        = errors.Register(ModuleName, 1122, "dispute is not open")
+    ErrInvalidBuyerRatio     = errors.Register(ModuleName, 1123, "buyer ratio must be between 0 and 1")
+    ErrInvalidBatch          = errors.Register(ModuleName, 1124, "invalid batch size")
+    ErrMaxTotalExceeded      = errors.Register(ModuleName, 1125, "total exceeds the buyer's maximum")
+    ErrRevisionMismatch      = errors.Register(ModuleName, 1126, "listing revision does not match the expected revision")
+    ErrInvalidOrder          = errors.Register(ModuleName, 1127, "invalid limit order")
+    ErrOrderNotOpen          = errors.Register(ModuleName, 1128, "order is not open")
+    ErrInvalidRoyalty        = errors.Register(ModuleName, 1129, "invalid royalty")
+    ErrInvalidCommission     = errors.Register(ModuleName, 1130, "invalid commission schedule")
+    ErrInvalidFeeSplit       = errors.Register(ModuleName, 1131, "invalid fee split")
+    ErrInvalidReferrer       = errors.Register(ModuleName, 1132, "invalid referrer")
+    ErrListingLimitReached   = errors.Register(ModuleName, 1133, "seller has too many active listings")
+    ErrListingDeposit        = errors.Register(ModuleName, 1134, "cannot lock listing deposit")
+    ErrInvalidCategory       = errors.Register(ModuleName, 1135, "invalid category")
+    ErrInvalidTag            = errors.Register(ModuleName, 1136, "invalid tag")
+    ErrInvalidTWAPWindow     = errors.Register(ModuleName, 1137, "invalid twap window")
+    ErrNoPriceHistory        = errors.Register(ModuleName, 1138, "not enough price history")
+    ErrMaxFeeExceeded        = errors.Register(ModuleName, 1139, "commission exceeds the buyer's maximum")
+    ErrOfferLimitReached     = errors.Register(ModuleName, 1140, "listing has too many open offers")
+    ErrInvalidSellerBond     = errors.Register(ModuleName, 1141, "invalid dispute seller bond")
 )
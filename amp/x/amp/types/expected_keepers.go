@@ -18,9 +18,23 @@ type AuthKeeper interface {
 type BankKeeper interface {
     SpendableCoins(context.Context, sdk.AccAddress) sdk.Coins
     SendCoins(context.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins) error
+    SendCoinsFromAccountToModule(context.Context, sdk.AccAddress, string, sdk.Coins) error
+    SendCoinsFromModuleToAccount(context.Context, string, sdk.AccAddress, sdk.Coins) error
+    BurnCoins(context.Context, string, sdk.Coins) error
     // Methods imported from bank should be defined here
 }
 
+// NFTKeeper defines the expected interface for the NFT module.
+type NFTKeeper interface {
+    GetOwner(ctx context.Context, classID, nftID string) sdk.AccAddress
+    Transfer(ctx context.Context, classID, nftID string, receiver sdk.AccAddress) error
+}
+
+// DistrKeeper defines the expected interface for the Distribution module.
+type DistrKeeper interface {
+    FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
@@ -16,6 +16,9 @@ const (
 
     // EscrowModuleName is the name used to derive the escrow module account address
     EscrowModuleName = ModuleName + "_escrow"
+
+    // TreasuryModuleName is the module account holding the treasury share of commissions
+    TreasuryModuleName = ModuleName + "_treasury"
 )
 
 // ParamsKey is the prefix to retrieve all Params
@@ -24,5 +27,110 @@ var ParamsKey = collections.NewPrefix("p_amp")
 // ListingsPrefix is the prefix to store all Listing objects
 var ListingsPrefix = collections.NewPrefix("l_amp")
 
+// ListingsBySellerPrefix indexes listings by (seller, listing id)
+var ListingsBySellerPrefix = collections.NewPrefix("lsel_amp")
+
+// ListingsByBuyerPrefix indexes listings by (buyer, listing id) for every buyer of a listing
+var ListingsByBuyerPrefix = collections.NewPrefix("lbuy_amp")
+
+// ListingsByStatusPrefix indexes listings by (status, listing id)
+var ListingsByStatusPrefix = collections.NewPrefix("lsts_amp")
+
+// ListingsByPricePrefix indexes open listings by (asset denom, price denom, unit price, listing id)
+var ListingsByPricePrefix = collections.NewPrefix("lprc_amp")
+
+// ListingsByCategoryPrefix indexes open listings by (category, listing id)
+var ListingsByCategoryPrefix = collections.NewPrefix("lcat_amp")
+
+// ListingsByTagPrefix indexes open listings by (tag, listing id)
+var ListingsByTagPrefix = collections.NewPrefix("ltag_amp")
+
 // ListingSeqKey stores the auto-incrementing ID for listings
 var ListingSeqKey = collections.NewPrefix("lseq_amp")
+
+// AuctionsPrefix is the prefix to store all Auction objects
+var AuctionsPrefix = collections.NewPrefix("a_amp")
+
+// AuctionSeqKey stores the auto-incrementing ID for auctions
+var AuctionSeqKey = collections.NewPrefix("aseq_amp")
+
+// AuctionsByEndPrefix indexes active auctions by (end time, id) for settlement
+var AuctionsByEndPrefix = collections.NewPrefix("aend_amp")
+
+// OffersPrefix is the prefix to store all Offer objects
+var OffersPrefix = collections.NewPrefix("o_amp")
+
+// OfferSeqKey stores the auto-incrementing ID for offers
+var OfferSeqKey = collections.NewPrefix("oseq_amp")
+
+// OffersByListingPrefix indexes offers by (listing id, offer id)
+var OffersByListingPrefix = collections.NewPrefix("olst_amp")
+
+// OffersByBuyerPrefix indexes offers by (buyer, offer id)
+var OffersByBuyerPrefix = collections.NewPrefix("obuy_amp")
+
+// OffersByExpiryPrefix indexes open expiring offers by (expiry time, offer id)
+var OffersByExpiryPrefix = collections.NewPrefix("oexp_amp")
+
+// ListingsByExpiryPrefix indexes active expiring listings by (expiry time, id)
+var ListingsByExpiryPrefix = collections.NewPrefix("lexp_amp")
+
+// PriceHistoryPrefix stores listing price changes by (listing id, revision)
+var PriceHistoryPrefix = collections.NewPrefix("lph_amp")
+
+// ListingAllowlistPrefix stores the eligible buyers of restricted listings by (listing id, address)
+var ListingAllowlistPrefix = collections.NewPrefix("lacl_amp")
+
+// ListingsByDeliveryDeadlinePrefix indexes sales awaiting delivery by (deadline, listing id)
+var ListingsByDeliveryDeadlinePrefix = collections.NewPrefix("ldlv_amp")
+
+// ListingsByNFTClassPrefix indexes NFT listings by (class id, listing id)
+var ListingsByNFTClassPrefix = collections.NewPrefix("lnft_amp")
+
+// DisputesPrefix stores disputes by listing id
+var DisputesPrefix = collections.NewPrefix("dsp_amp")
+
+// DisputesByDeadlinePrefix indexes open disputes by (deadline, listing id) for the timeout
+var DisputesByDeadlinePrefix = collections.NewPrefix("ddl_amp")
+
+// ArbitersPrefix stores the addresses appointed to resolve disputes
+var ArbitersPrefix = collections.NewPrefix("arb_amp")
+
+// OrdersPrefix is the prefix to store all limit Order objects
+var OrdersPrefix = collections.NewPrefix("ord_amp")
+
+// OrderSeqKey stores the auto-incrementing ID for limit orders
+var OrderSeqKey = collections.NewPrefix("ordseq_amp")
+
+// OrderBookBidsPrefix indexes open buy orders by (book, inverted unit price, order id)
+var OrderBookBidsPrefix = collections.NewPrefix("bid_amp")
+
+// OrderBookAsksPrefix indexes open sell orders by (book, unit price, order id)
+var OrderBookAsksPrefix = collections.NewPrefix("ask_amp")
+
+// RoyaltiesPrefix stores registered royalties by (asset kind, denom or class id)
+var RoyaltiesPrefix = collections.NewPrefix("roy_amp")
+
+// CreatorsPrefix stores the creator of an asset, its first lister or the one the authority assigned, by (asset kind, denom or class id)
+var CreatorsPrefix = collections.NewPrefix("crt_amp")
+
+// ReferrerStatsPrefix stores the referral totals of every referrer by address
+var ReferrerStatsPrefix = collections.NewPrefix("ref_amp")
+
+// ActiveListingCountsPrefix stores the number of open listings of every seller by address
+var ActiveListingCountsPrefix = collections.NewPrefix("lcnt_amp")
+
+// CategoriesPrefix stores the listing categories registered by the module authority
+var CategoriesPrefix = collections.NewPrefix("cat_amp")
+
+// DenomStatsPrefix stores the running sale totals of every price denom
+var DenomStatsPrefix = collections.NewPrefix("sden_amp")
+
+// AssetStatsPrefix stores the running unit price stats of every (asset denom, price denom) pair
+var AssetStatsPrefix = collections.NewPrefix("sast_amp")
+
+// StatsSnapshotsPrefix stores the market stats snapshotted at the end of every stats epoch by epoch number
+var StatsSnapshotsPrefix = collections.NewPrefix("ssnp_amp")
+
+// TwapRecordsPrefix stores the price accumulator of every (asset denom, price denom) pair by sale time
+var TwapRecordsPrefix = collections.NewPrefix("twap_amp")
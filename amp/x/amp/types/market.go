@@ -0,0 +1,287 @@
+package types
+
+import (
+    "bytes"
+    "crypto/sha256"
+    "regexp"
+
+    errorsmod "cosmossdk.io/errors"
+    sdkmath "cosmossdk.io/math"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+    sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// ListingTerms are what a seller lists an item with, see Keeper.ListItem.
+type ListingTerms struct {
+    Title       string
+    Description string
+    // Asset is the coin bundle for sale; empty when selling NFT
+    Asset sdk.Coins
+    // Price holds the alternative payment options, or with PartialFills the price per unit
+    Price sdk.Coins
+    // Dutch turns the single price option into the start price of a declining-price sale
+    Dutch *DutchAuction
+    // ExpiresAt returns the asset to the seller once that block time is reached; 0 for never
+    ExpiresAt int64
+    // PartialFills lets buyers take any quantity of a single-coin asset
+    PartialFills bool
+    // Restriction limits who may buy; its addresses are kept apart from the listing
+    Restriction *ListingRestriction
+    // NFT sells the seller's x/nft token instead of coins
+    NFT *NFTAsset
+    // DeliveryEscrow holds the payment until the buyer confirms delivery or it times out
+    DeliveryEscrow bool
+    // Referrer earns a referral share of the commission of every sale of the listing
+    Referrer sdk.AccAddress
+    // Category must be registered with UpdateCategories; tags are free
+    Category string
+    Tags     []string
+}
+
+// BuyOptions are a buyer's choices and guards for a purchase, see Keeper.BuyItem.
+type BuyOptions struct {
+    // Quantity to buy; nil or zero buys everything remaining
+    Quantity sdkmath.Int
+    // Denom selects the price option to pay in
+    Denom string
+    // Proof proves eligibility for merkle-restricted listings
+    Proof [][]byte
+    // MaxTotal fails the purchase if it would cost more
+    MaxTotal *sdk.Coin
+    // MaxFee fails the purchase if the commission on it would be more
+    MaxFee *sdk.Coin
+    // ExpectedRevision fails the purchase if the listing was updated since
+    ExpectedRevision *uint64
+    // Referrer earns a referral share of the commission
+    Referrer sdk.AccAddress
+}
+
+// ValidateListingTerms checks the sale terms of a new or updated listing: a non-empty
+// asset bundle or an NFT, and at least one price option, of which declining-price
+// listings may have only one. Partially fillable listings must sell a single coin.
+func ValidateListingTerms(asset, price sdk.Coins, dutch *DutchAuction, partialFills bool, nft *NFTAsset) error {
+    if err := asset.Validate(); err != nil {
+        return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+    }
+    if nft != nil {
+        if err := nft.Validate(); err != nil {
+            return err
+        }
+        if !asset.Empty() {
+            return errorsmod.Wrap(ErrInvalidNFT, "NFT listings sell no coins")
+        }
+        if partialFills {
+            return errorsmod.Wrap(ErrInvalidNFT, "NFT listings cannot be partially filled")
+        }
+    } else if asset.Empty() {
+        return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "asset must not be empty")
+    }
+    if err := price.Validate(); err != nil {
+        return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+    }
+    if price.Empty() {
+        return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, "at least one price option is required")
+    }
+    if dutch != nil {
+        if len(price) != 1 {
+            return errorsmod.Wrap(ErrInvalidDutchAuction, "declining price listings take a single price option")
+        }
+        if err := dutch.Validate(price[0]); err != nil {
+            return err
+        }
+    }
+    if partialFills && len(asset) != 1 {
+        return errorsmod.Wrap(ErrInvalidQuantity, "partially fillable listings sell a single coin")
+    }
+    return nil
+}
+
+// MaxTags is the most tags a listing may have.
+const MaxTags = 5
+
+// taxonomyName matches a category or tag: 1 to 32 lower case letters, digits and dashes,
+// not starting with a dash.
+var taxonomyName = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,31}$`)
+
+// ValidateCategoryName checks the name of a category.
+func ValidateCategoryName(category string) error {
+    if !taxonomyName.MatchString(category) {
+        return errorsmod.Wrapf(ErrInvalidCategory, "%q must be 1 to 32 lower case letters, digits and dashes", category)
+    }
+    return nil
+}
+
+// ValidateTags checks the tags of a listing: at most MaxTags distinct names of the same
+// form as a category.
+func ValidateTags(tags []string) error {
+    if len(tags) > MaxTags {
+        return errorsmod.Wrapf(ErrInvalidTag, "at most %d tags", MaxTags)
+    }
+    seen := make(map[string]bool, len(tags))
+    for _, tag := range tags {
+        if !taxonomyName.MatchString(tag) {
+            return errorsmod.Wrapf(ErrInvalidTag, "%q must be 1 to 32 lower case letters, digits and dashes", tag)
+        }
+        if seen[tag] {
+            return errorsmod.Wrapf(ErrInvalidTag, "duplicate tag %s", tag)
+        }
+        seen[tag] = true
+    }
+    return nil
+}
+
+// Validate checks that an NFT asset names both its class and token.
+func (n NFTAsset) Validate() error {
+    if n.ClassId == "" || n.NftId == "" {
+        return errorsmod.Wrap(ErrInvalidNFT, "class id and nft id are required")
+    }
+    return nil
+}
+
+// Validate checks a declining price configuration against the listing's start price.
+func (d DutchAuction) Validate(startPrice sdk.Coin) error {
+    if err := d.FloorPrice.Validate(); err != nil {
+        return errorsmod.Wrap(ErrInvalidDutchAuction, err.Error())
+    }
+    if d.FloorPrice.Denom != startPrice.Denom {
+        return errorsmod.Wrapf(ErrInvalidDutchAuction, "floor price denom must be %s", startPrice.Denom)
+    }
+    if d.FloorPrice.Amount.GT(startPrice.Amount) {
+        return errorsmod.Wrap(ErrInvalidDutchAuction, "floor price must not exceed the start price")
+    }
+    if d.Duration == 0 {
+        return errorsmod.Wrap(ErrInvalidDutchAuction, "duration must be positive")
+    }
+    if _, ok := DecayUnit_name[int32(d.Unit)]; !ok {
+        return errorsmod.Wrapf(ErrInvalidDutchAuction, "unknown decay unit %d", d.Unit)
+    }
+    return nil
+}
+
+// PriceAt returns the listing's price options at the given block height and time.
+// Fixed-price listings always return Price; declining-price listings interpolate their
+// single option linearly down to the floor price and stay there once the duration has
+// elapsed.
+func (l Listing) PriceAt(height, unixTime int64) sdk.Coins {
+    d := l.Dutch
+    if d == nil {
+        return l.Price
+    }
+
+    elapsed := unixTime - d.StartTime
+    if d.Unit == DecayUnit_DECAY_UNIT_BLOCKS {
+        elapsed = height - d.StartHeight
+    }
+    if elapsed <= 0 {
+        return l.Price
+    }
+    if uint64(elapsed) >= d.Duration {
+        return sdk.Coins{d.FloorPrice}
+    }
+
+    // decay is rounded down so the price never drops below the linear schedule
+    start := l.Price[0]
+    spread := start.Amount.Sub(d.FloorPrice.Amount)
+    decay := spread.Mul(sdkmath.NewInt(elapsed)).Quo(sdkmath.NewIntFromUint64(d.Duration))
+    return sdk.Coins{sdk.NewCoin(start.Denom, start.Amount.Sub(decay))}
+}
+
+// IsOpen reports whether a listing can still be bought, delisted or receive offers.
+func (l Listing) IsOpen() bool {
+    return l.Status == ListingStatus_LISTING_STATUS_ACTIVE || l.Status == ListingStatus_LISTING_STATUS_PARTIALLY_FILLED
+}
+
+// UnitPrices returns the asset denom of a listing and its price per unit of asset in
+// each price option. Only open, public, fixed-price listings of a single fungible denom
+// have unit prices; ok is false for all others.
+func (l Listing) UnitPrices() (assetDenom string, prices sdk.DecCoins, ok bool) {
+    if !l.IsOpen() || l.Nft != nil || l.Dutch != nil || l.Access != ListingAccess_LISTING_ACCESS_PUBLIC || len(l.RemainingAssets) != 1 {
+        return "", nil, false
+    }
+    remaining := l.RemainingAssets[0]
+    for _, price := range l.Price {
+        unitPrice := sdkmath.LegacyNewDecFromInt(price.Amount)
+        // partially fillable listings are already priced per unit
+        if !l.PartialFills {
+            unitPrice = unitPrice.QuoInt(remaining.Amount)
+        }
+        prices = append(prices, sdk.NewDecCoinFromDec(price.Denom, unitPrice))
+    }
+    return remaining.Denom, prices, true
+}
+
+// Referrers returns the referrers paid on the release of a delivery escrow sale: the
+// seller's and the buyer's, either of which may be empty.
+func (l Listing) Referrers() []string {
+    return []string{l.Referrer, l.BuyerReferrer}
+}
+
+// PriceIn returns the option of prices denominated in denom. An empty denom selects the
+// only option of a single-option listing.
+func PriceIn(prices sdk.Coins, denom string) (sdk.Coin, error) {
+    if denom == "" && len(prices) == 1 {
+        return prices[0], nil
+    }
+    if found, price := prices.Find(denom); found {
+        return price, nil
+    }
+    return sdk.Coin{}, errorsmod.Wrapf(ErrInvalidDenom, "pay with one of %s", prices)
+}
+
+// MaxAllowlistSize caps the number of addresses a listing allowlist may name; larger sets
+// should be committed to as a merkle root instead.
+const MaxAllowlistSize = 100
+
+// Access returns the kind of restriction r describes, or an error if it sets more than
+// one kind, too many addresses or a merkle root of the wrong size.
+func (r ListingRestriction) Access() (ListingAccess, error) {
+    var access ListingAccess
+    set := 0
+    if r.ReservedBuyer != "" {
+        access = ListingAccess_LISTING_ACCESS_RESERVED
+        set++
+    }
+    if len(r.Allowlist) > 0 {
+        access = ListingAccess_LISTING_ACCESS_ALLOWLIST
+        set++
+    }
+    if len(r.MerkleRoot) > 0 {
+        access = ListingAccess_LISTING_ACCESS_MERKLE
+        set++
+    }
+    if set > 1 {
+        return 0, errorsmod.Wrap(ErrInvalidRestriction, "set only one of reserved buyer, allowlist or merkle root")
+    }
+    if len(r.Allowlist) > MaxAllowlistSize {
+        return 0, errorsmod.Wrapf(ErrInvalidRestriction, "allowlist may name at most %d addresses", MaxAllowlistSize)
+    }
+    if len(r.MerkleRoot) > 0 && len(r.MerkleRoot) != sha256.Size {
+        return 0, errorsmod.Wrapf(ErrInvalidRestriction, "merkle root must be %d bytes", sha256.Size)
+    }
+    return access, nil
+}
+
+// MerkleLeaf returns the leaf hash committing to a buyer address in a listing's merkle tree.
+func MerkleLeaf(addr []byte) []byte {
+    h := sha256.Sum256(addr)
+    return h[:]
+}
+
+// VerifyMerkleProof reports whether proof links leaf to root. Each step hashes the
+// current node with the next proof element in sorted order, so proofs need no
+// left/right flags.
+func VerifyMerkleProof(root, leaf []byte, proof [][]byte) bool {
+    node := leaf
+    for _, sibling := range proof {
+        h := sha256.New()
+        if bytes.Compare(node, sibling) <= 0 {
+            h.Write(node)
+            h.Write(sibling)
+        } else {
+            h.Write(sibling)
+            h.Write(node)
+        }
+        node = h.Sum(nil)
+    }
+    return bytes.Equal(node, root)
+}
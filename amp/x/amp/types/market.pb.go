@@ -4,7 +4,10 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -28,21 +31,39 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type ListingStatus int32
 
 const (
-	ListingStatus_LISTING_STATUS_ACTIVE    ListingStatus = 0
-	ListingStatus_LISTING_STATUS_SOLD      ListingStatus = 1
-	ListingStatus_LISTING_STATUS_CANCELLED ListingStatus = 2
+	ListingStatus_LISTING_STATUS_ACTIVE            ListingStatus = 0
+	ListingStatus_LISTING_STATUS_SOLD              ListingStatus = 1
+	ListingStatus_LISTING_STATUS_CANCELLED         ListingStatus = 2
+	ListingStatus_LISTING_STATUS_EXPIRED           ListingStatus = 3
+	ListingStatus_LISTING_STATUS_PARTIALLY_FILLED  ListingStatus = 4
+	ListingStatus_LISTING_STATUS_AWAITING_DELIVERY ListingStatus = 5
+	ListingStatus_LISTING_STATUS_COMPLETED         ListingStatus = 6
+	ListingStatus_LISTING_STATUS_DISPUTED          ListingStatus = 7
+	ListingStatus_LISTING_STATUS_TAKEN_DOWN        ListingStatus = 8
 )
 
 var ListingStatus_name = map[int32]string{
 	0: "LISTING_STATUS_ACTIVE",
 	1: "LISTING_STATUS_SOLD",
 	2: "LISTING_STATUS_CANCELLED",
+	3: "LISTING_STATUS_EXPIRED",
+	4: "LISTING_STATUS_PARTIALLY_FILLED",
+	5: "LISTING_STATUS_AWAITING_DELIVERY",
+	6: "LISTING_STATUS_COMPLETED",
+	7: "LISTING_STATUS_DISPUTED",
+	8: "LISTING_STATUS_TAKEN_DOWN",
 }
 
 var ListingStatus_value = map[string]int32{
-	"LISTING_STATUS_ACTIVE":    0,
-	"LISTING_STATUS_SOLD":      1,
-	"LISTING_STATUS_CANCELLED": 2,
+	"LISTING_STATUS_ACTIVE":            0,
+	"LISTING_STATUS_SOLD":              1,
+	"LISTING_STATUS_CANCELLED":         2,
+	"LISTING_STATUS_EXPIRED":           3,
+	"LISTING_STATUS_PARTIALLY_FILLED":  4,
+	"LISTING_STATUS_AWAITING_DELIVERY": 5,
+	"LISTING_STATUS_COMPLETED":         6,
+	"LISTING_STATUS_DISPUTED":          7,
+	"LISTING_STATUS_TAKEN_DOWN":        8,
 }
 
 func (x ListingStatus) String() string {
@@ -53,31 +74,85 @@ func (ListingStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_7903d53ef308f134, []int{0}
 }
 
-// Listing represents a marketplace item listed for sale
-type Listing struct {
-	Id          uint64        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Seller      string        `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
-	Title       string        `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description string        `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	Asset       types.Coin    `protobuf:"bytes,5,opt,name=asset,proto3" json:"asset"`
-	Price       types.Coin    `protobuf:"bytes,6,opt,name=price,proto3" json:"price"`
-	Status      ListingStatus `protobuf:"varint,7,opt,name=status,proto3,enum=amp.amp.v1.ListingStatus" json:"status,omitempty"`
-	Buyer       string        `protobuf:"bytes,8,opt,name=buyer,proto3" json:"buyer,omitempty"`
-	CreatedAt   int64         `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+// ListingAccess restricts who may buy a listing
+type ListingAccess int32
+
+const (
+	ListingAccess_LISTING_ACCESS_PUBLIC    ListingAccess = 0
+	ListingAccess_LISTING_ACCESS_RESERVED  ListingAccess = 1
+	ListingAccess_LISTING_ACCESS_ALLOWLIST ListingAccess = 2
+	ListingAccess_LISTING_ACCESS_MERKLE    ListingAccess = 3
+)
+
+var ListingAccess_name = map[int32]string{
+	0: "LISTING_ACCESS_PUBLIC",
+	1: "LISTING_ACCESS_RESERVED",
+	2: "LISTING_ACCESS_ALLOWLIST",
+	3: "LISTING_ACCESS_MERKLE",
 }
 
-func (m *Listing) Reset()         { *m = Listing{} }
-func (m *Listing) String() string { return proto.CompactTextString(m) }
-func (*Listing) ProtoMessage()    {}
-func (*Listing) Descriptor() ([]byte, []int) {
+var ListingAccess_value = map[string]int32{
+	"LISTING_ACCESS_PUBLIC":    0,
+	"LISTING_ACCESS_RESERVED":  1,
+	"LISTING_ACCESS_ALLOWLIST": 2,
+	"LISTING_ACCESS_MERKLE":    3,
+}
+
+func (x ListingAccess) String() string {
+	return proto.EnumName(ListingAccess_name, int32(x))
+}
+
+func (ListingAccess) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7903d53ef308f134, []int{1}
+}
+
+// DecayUnit selects what a declining price is measured against
+type DecayUnit int32
+
+const (
+	DecayUnit_DECAY_UNIT_SECONDS DecayUnit = 0
+	DecayUnit_DECAY_UNIT_BLOCKS  DecayUnit = 1
+)
+
+var DecayUnit_name = map[int32]string{
+	0: "DECAY_UNIT_SECONDS",
+	1: "DECAY_UNIT_BLOCKS",
+}
+
+var DecayUnit_value = map[string]int32{
+	"DECAY_UNIT_SECONDS": 0,
+	"DECAY_UNIT_BLOCKS":  1,
+}
+
+func (x DecayUnit) String() string {
+	return proto.EnumName(DecayUnit_name, int32(x))
+}
+
+func (DecayUnit) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7903d53ef308f134, []int{2}
+}
+
+// ListingRestriction names the buyers eligible for a listing. At most one field may be set.
+type ListingRestriction struct {
+	ReservedBuyer string   `protobuf:"bytes,1,opt,name=reserved_buyer,json=reservedBuyer,proto3" json:"reserved_buyer,omitempty"`
+	Allowlist     []string `protobuf:"bytes,2,rep,name=allowlist,proto3" json:"allowlist,omitempty"`
+	// merkle_root is the root of a tree whose leaves are sha256(buyer address bytes) and
+	// whose nodes hash the sorted concatenation of their children.
+	MerkleRoot []byte `protobuf:"bytes,3,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+}
+
+func (m *ListingRestriction) Reset()         { *m = ListingRestriction{} }
+func (m *ListingRestriction) String() string { return proto.CompactTextString(m) }
+func (*ListingRestriction) ProtoMessage()    {}
+func (*ListingRestriction) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7903d53ef308f134, []int{0}
 }
-func (m *Listing) XXX_Unmarshal(b []byte) error {
+func (m *ListingRestriction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Listing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ListingRestriction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Listing.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ListingRestriction.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -87,102 +162,114 @@ func (m *Listing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Listing) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Listing.Merge(m, src)
+func (m *ListingRestriction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListingRestriction.Merge(m, src)
 }
-func (m *Listing) XXX_Size() int {
+func (m *ListingRestriction) XXX_Size() int {
 	return m.Size()
 }
-func (m *Listing) XXX_DiscardUnknown() {
-	xxx_messageInfo_Listing.DiscardUnknown(m)
+func (m *ListingRestriction) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListingRestriction.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Listing proto.InternalMessageInfo
-
-func (m *Listing) GetId() uint64 {
-	if m != nil {
-		return m.Id
-	}
-	return 0
-}
+var xxx_messageInfo_ListingRestriction proto.InternalMessageInfo
 
-func (m *Listing) GetSeller() string {
+func (m *ListingRestriction) GetReservedBuyer() string {
 	if m != nil {
-		return m.Seller
+		return m.ReservedBuyer
 	}
 	return ""
 }
 
-func (m *Listing) GetTitle() string {
+func (m *ListingRestriction) GetAllowlist() []string {
 	if m != nil {
-		return m.Title
+		return m.Allowlist
 	}
-	return ""
+	return nil
 }
 
-func (m *Listing) GetDescription() string {
+func (m *ListingRestriction) GetMerkleRoot() []byte {
 	if m != nil {
-		return m.Description
+		return m.MerkleRoot
 	}
-	return ""
+	return nil
 }
 
-func (m *Listing) GetAsset() types.Coin {
-	if m != nil {
-		return m.Asset
-	}
-	return types.Coin{}
+// NFTAsset identifies an x/nft token sold by a listing
+type NFTAsset struct {
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	NftId   string `protobuf:"bytes,2,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
 }
 
-func (m *Listing) GetPrice() types.Coin {
-	if m != nil {
-		return m.Price
-	}
-	return types.Coin{}
+func (m *NFTAsset) Reset()         { *m = NFTAsset{} }
+func (m *NFTAsset) String() string { return proto.CompactTextString(m) }
+func (*NFTAsset) ProtoMessage()    {}
+func (*NFTAsset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903d53ef308f134, []int{1}
 }
-
-func (m *Listing) GetStatus() ListingStatus {
-	if m != nil {
-		return m.Status
+func (m *NFTAsset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NFTAsset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NFTAsset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ListingStatus_LISTING_STATUS_ACTIVE
+}
+func (m *NFTAsset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NFTAsset.Merge(m, src)
+}
+func (m *NFTAsset) XXX_Size() int {
+	return m.Size()
+}
+func (m *NFTAsset) XXX_DiscardUnknown() {
+	xxx_messageInfo_NFTAsset.DiscardUnknown(m)
 }
 
-func (m *Listing) GetBuyer() string {
+var xxx_messageInfo_NFTAsset proto.InternalMessageInfo
+
+func (m *NFTAsset) GetClassId() string {
 	if m != nil {
-		return m.Buyer
+		return m.ClassId
 	}
 	return ""
 }
 
-func (m *Listing) GetCreatedAt() int64 {
+func (m *NFTAsset) GetNftId() string {
 	if m != nil {
-		return m.CreatedAt
+		return m.NftId
 	}
-	return 0
+	return ""
 }
 
-// Event emitted when an item is listed
-type EventItemListed struct {
-	Id        uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Seller    string     `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
-	Asset     types.Coin `protobuf:"bytes,3,opt,name=asset,proto3" json:"asset"`
-	Price     types.Coin `protobuf:"bytes,4,opt,name=price,proto3" json:"price"`
-	CreatedAt int64      `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+// DutchAuction makes a listing's price decline linearly from the listing price to
+// floor_price over duration blocks or seconds, counted from when it was listed
+type DutchAuction struct {
+	FloorPrice  types.Coin `protobuf:"bytes,1,opt,name=floor_price,json=floorPrice,proto3" json:"floor_price"`
+	Unit        DecayUnit  `protobuf:"varint,2,opt,name=unit,proto3,enum=amp.amp.v1.DecayUnit" json:"unit,omitempty"`
+	Duration    uint64     `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	StartHeight int64      `protobuf:"varint,4,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	StartTime   int64      `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
 }
 
-func (m *EventItemListed) Reset()         { *m = EventItemListed{} }
-func (m *EventItemListed) String() string { return proto.CompactTextString(m) }
-func (*EventItemListed) ProtoMessage()    {}
-func (*EventItemListed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7903d53ef308f134, []int{1}
+func (m *DutchAuction) Reset()         { *m = DutchAuction{} }
+func (m *DutchAuction) String() string { return proto.CompactTextString(m) }
+func (*DutchAuction) ProtoMessage()    {}
+func (*DutchAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903d53ef308f134, []int{2}
 }
-func (m *EventItemListed) XXX_Unmarshal(b []byte) error {
+func (m *DutchAuction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventItemListed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DutchAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventItemListed.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DutchAuction.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -192,76 +279,95 @@ func (m *EventItemListed) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *EventItemListed) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventItemListed.Merge(m, src)
+func (m *DutchAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DutchAuction.Merge(m, src)
 }
-func (m *EventItemListed) XXX_Size() int {
+func (m *DutchAuction) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventItemListed) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventItemListed.DiscardUnknown(m)
+func (m *DutchAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_DutchAuction.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventItemListed proto.InternalMessageInfo
+var xxx_messageInfo_DutchAuction proto.InternalMessageInfo
 
-func (m *EventItemListed) GetId() uint64 {
+func (m *DutchAuction) GetFloorPrice() types.Coin {
 	if m != nil {
-		return m.Id
+		return m.FloorPrice
 	}
-	return 0
+	return types.Coin{}
 }
 
-func (m *EventItemListed) GetSeller() string {
+func (m *DutchAuction) GetUnit() DecayUnit {
 	if m != nil {
-		return m.Seller
+		return m.Unit
 	}
-	return ""
+	return DecayUnit_DECAY_UNIT_SECONDS
 }
 
-func (m *EventItemListed) GetAsset() types.Coin {
+func (m *DutchAuction) GetDuration() uint64 {
 	if m != nil {
-		return m.Asset
+		return m.Duration
 	}
-	return types.Coin{}
+	return 0
 }
 
-func (m *EventItemListed) GetPrice() types.Coin {
+func (m *DutchAuction) GetStartHeight() int64 {
 	if m != nil {
-		return m.Price
+		return m.StartHeight
 	}
-	return types.Coin{}
+	return 0
 }
 
-func (m *EventItemListed) GetCreatedAt() int64 {
+func (m *DutchAuction) GetStartTime() int64 {
 	if m != nil {
-		return m.CreatedAt
+		return m.StartTime
 	}
 	return 0
 }
 
-// Event emitted when an item is bought
-type EventItemBought struct {
-	Id           uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Seller       string     `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
-	Buyer        string     `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
-	Asset        types.Coin `protobuf:"bytes,4,opt,name=asset,proto3" json:"asset"`
-	Price        types.Coin `protobuf:"bytes,5,opt,name=price,proto3" json:"price"`
-	Fee          types.Coin `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee"`
-	SellerAmount types.Coin `protobuf:"bytes,7,opt,name=seller_amount,json=sellerAmount,proto3" json:"seller_amount"`
+// Listing represents a marketplace item listed for sale
+type Listing struct {
+	Id               uint64                                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Seller           string                                   `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	Title            string                                   `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description      string                                   `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Asset            github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=asset,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"asset"`
+	Price            github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
+	Status           ListingStatus                            `protobuf:"varint,7,opt,name=status,proto3,enum=amp.amp.v1.ListingStatus" json:"status,omitempty"`
+	Buyer            string                                   `protobuf:"bytes,8,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	CreatedAt        int64                                    `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Dutch            *DutchAuction                            `protobuf:"bytes,10,opt,name=dutch,proto3" json:"dutch,omitempty"`
+	ExpiresAt        int64                                    `protobuf:"varint,11,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	PartialFills     bool                                     `protobuf:"varint,12,opt,name=partial_fills,json=partialFills,proto3" json:"partial_fills,omitempty"`
+	Revision         uint64                                   `protobuf:"varint,14,opt,name=revision,proto3" json:"revision,omitempty"`
+	Access           ListingAccess                            `protobuf:"varint,15,opt,name=access,proto3,enum=amp.amp.v1.ListingAccess" json:"access,omitempty"`
+	MerkleRoot       []byte                                   `protobuf:"bytes,16,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	RemainingAssets  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,17,rep,name=remaining_assets,json=remainingAssets,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"remaining_assets"`
+	Nft              *NFTAsset                                `protobuf:"bytes,18,opt,name=nft,proto3" json:"nft,omitempty"`
+	DeliveryEscrow   bool                                     `protobuf:"varint,19,opt,name=delivery_escrow,json=deliveryEscrow,proto3" json:"delivery_escrow,omitempty"`
+	EscrowedPayment  types.Coin                               `protobuf:"bytes,20,opt,name=escrowed_payment,json=escrowedPayment,proto3" json:"escrowed_payment"`
+	DeliveryDeadline int64                                    `protobuf:"varint,21,opt,name=delivery_deadline,json=deliveryDeadline,proto3" json:"delivery_deadline,omitempty"`
+	Referrer         string                                   `protobuf:"bytes,22,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	BuyerReferrer    string                                   `protobuf:"bytes,23,opt,name=buyer_referrer,json=buyerReferrer,proto3" json:"buyer_referrer,omitempty"`
+	Deposit          *types.Coin                              `protobuf:"bytes,24,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	Category         string                                   `protobuf:"bytes,25,opt,name=category,proto3" json:"category,omitempty"`
+	Tags             []string                                 `protobuf:"bytes,26,rep,name=tags,proto3" json:"tags,omitempty"`
+	EscrowedFee      types.Coin                               `protobuf:"bytes,27,opt,name=escrowed_fee,json=escrowedFee,proto3" json:"escrowed_fee"`
 }
 
-func (m *EventItemBought) Reset()         { *m = EventItemBought{} }
-func (m *EventItemBought) String() string { return proto.CompactTextString(m) }
-func (*EventItemBought) ProtoMessage()    {}
-func (*EventItemBought) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7903d53ef308f134, []int{2}
+func (m *Listing) Reset()         { *m = Listing{} }
+func (m *Listing) String() string { return proto.CompactTextString(m) }
+func (*Listing) ProtoMessage()    {}
+func (*Listing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903d53ef308f134, []int{3}
 }
-func (m *EventItemBought) XXX_Unmarshal(b []byte) error {
+func (m *Listing) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventItemBought) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Listing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventItemBought.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Listing.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -271,552 +377,5372 @@ func (m *EventItemBought) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *EventItemBought) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventItemBought.Merge(m, src)
+func (m *Listing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Listing.Merge(m, src)
 }
-func (m *EventItemBought) XXX_Size() int {
+func (m *Listing) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventItemBought) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventItemBought.DiscardUnknown(m)
+func (m *Listing) XXX_DiscardUnknown() {
+	xxx_messageInfo_Listing.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventItemBought proto.InternalMessageInfo
+var xxx_messageInfo_Listing proto.InternalMessageInfo
 
-func (m *EventItemBought) GetId() uint64 {
+func (m *Listing) GetId() uint64 {
 	if m != nil {
 		return m.Id
 	}
 	return 0
 }
 
-func (m *EventItemBought) GetSeller() string {
+func (m *Listing) GetSeller() string {
 	if m != nil {
 		return m.Seller
 	}
 	return ""
 }
 
-func (m *EventItemBought) GetBuyer() string {
+func (m *Listing) GetTitle() string {
 	if m != nil {
-		return m.Buyer
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Listing) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
 	return ""
 }
 
-func (m *EventItemBought) GetAsset() types.Coin {
+func (m *Listing) GetAsset() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.Asset
 	}
-	return types.Coin{}
+	return nil
 }
 
-func (m *EventItemBought) GetPrice() types.Coin {
+func (m *Listing) GetPrice() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.Price
 	}
-	return types.Coin{}
+	return nil
 }
 
-func (m *EventItemBought) GetFee() types.Coin {
+func (m *Listing) GetStatus() ListingStatus {
 	if m != nil {
-		return m.Fee
+		return m.Status
 	}
-	return types.Coin{}
+	return ListingStatus_LISTING_STATUS_ACTIVE
 }
 
-func (m *EventItemBought) GetSellerAmount() types.Coin {
+func (m *Listing) GetBuyer() string {
 	if m != nil {
-		return m.SellerAmount
+		return m.Buyer
 	}
-	return types.Coin{}
+	return ""
 }
 
-// Event emitted when an item is delisted
-type EventItemDelisted struct {
-	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+func (m *Listing) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
 }
 
-func (m *EventItemDelisted) Reset()         { *m = EventItemDelisted{} }
-func (m *EventItemDelisted) String() string { return proto.CompactTextString(m) }
-func (*EventItemDelisted) ProtoMessage()    {}
-func (*EventItemDelisted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7903d53ef308f134, []int{3}
-}
-func (m *EventItemDelisted) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *EventItemDelisted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_EventItemDelisted.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *Listing) GetDutch() *DutchAuction {
+	if m != nil {
+		return m.Dutch
 	}
+	return nil
 }
-func (m *EventItemDelisted) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventItemDelisted.Merge(m, src)
-}
-func (m *EventItemDelisted) XXX_Size() int {
-	return m.Size()
-}
-func (m *EventItemDelisted) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventItemDelisted.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_EventItemDelisted proto.InternalMessageInfo
 
-func (m *EventItemDelisted) GetId() uint64 {
+func (m *Listing) GetExpiresAt() int64 {
 	if m != nil {
-		return m.Id
+		return m.ExpiresAt
 	}
 	return 0
 }
 
-func (m *EventItemDelisted) GetSeller() string {
+func (m *Listing) GetPartialFills() bool {
 	if m != nil {
-		return m.Seller
+		return m.PartialFills
 	}
-	return ""
+	return false
 }
 
-func init() {
-	proto.RegisterEnum("amp.amp.v1.ListingStatus", ListingStatus_name, ListingStatus_value)
-	proto.RegisterType((*Listing)(nil), "amp.amp.v1.Listing")
-	proto.RegisterType((*EventItemListed)(nil), "amp.amp.v1.EventItemListed")
-	proto.RegisterType((*EventItemBought)(nil), "amp.amp.v1.EventItemBought")
-	proto.RegisterType((*EventItemDelisted)(nil), "amp.amp.v1.EventItemDelisted")
+func (m *Listing) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("amp/amp/v1/market.proto", fileDescriptor_7903d53ef308f134) }
+func (m *Listing) GetAccess() ListingAccess {
+	if m != nil {
+		return m.Access
+	}
+	return ListingAccess_LISTING_ACCESS_PUBLIC
+}
 
-var fileDescriptor_7903d53ef308f134 = []byte{
-	// 501 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x6f, 0xd3, 0x30,
-	0x18, 0xad, 0xd3, 0xa6, 0x63, 0x1e, 0xdb, 0x8a, 0x19, 0xcc, 0x9d, 0x20, 0x44, 0x3d, 0x05, 0x0e,
-	0x89, 0x32, 0xc4, 0x89, 0x53, 0xfa, 0x43, 0xa8, 0x52, 0x34, 0xa4, 0xa4, 0x70, 0xe0, 0x52, 0xb9,
-	0x89, 0x29, 0x16, 0x4d, 0x1c, 0xc5, 0x6e, 0xc5, 0xfe, 0x0b, 0xfe, 0x1a, 0xae, 0xdc, 0xd0, 0x8e,
-	0x3b, 0x72, 0x42, 0xa8, 0xfd, 0x47, 0x50, 0x9c, 0xa8, 0x74, 0x3d, 0xb5, 0x3b, 0x58, 0xb2, 0xbf,
-	0xef, 0xbd, 0xe8, 0x7b, 0x2f, 0x4f, 0x1f, 0x3c, 0x27, 0x49, 0xe6, 0x14, 0x67, 0xe1, 0x3a, 0x09,
-	0xc9, 0xbf, 0x52, 0x69, 0x67, 0x39, 0x97, 0x1c, 0x41, 0x92, 0x64, 0x76, 0x71, 0x16, 0xee, 0x85,
-	0x11, 0x71, 0x91, 0x70, 0xe1, 0x4c, 0x88, 0xa0, 0xce, 0xc2, 0x9d, 0x50, 0x49, 0x5c, 0x27, 0xe2,
-	0x2c, 0x2d, 0xb1, 0x17, 0x67, 0x53, 0x3e, 0xe5, 0xea, 0xea, 0x14, 0xb7, 0xb2, 0xda, 0xf9, 0xa9,
-	0xc1, 0x03, 0x9f, 0x09, 0xc9, 0xd2, 0x29, 0x3a, 0x81, 0x1a, 0x8b, 0x31, 0x30, 0x81, 0xd5, 0x08,
-	0x34, 0x16, 0xa3, 0xa7, 0xb0, 0x29, 0xe8, 0x6c, 0x46, 0x73, 0xac, 0x99, 0xc0, 0x3a, 0x0c, 0xaa,
-	0x17, 0x3a, 0x83, 0xba, 0x64, 0x72, 0x46, 0x71, 0x5d, 0x95, 0xcb, 0x07, 0x32, 0xe1, 0x51, 0x4c,
-	0x45, 0x94, 0xb3, 0x4c, 0x32, 0x9e, 0xe2, 0x86, 0xea, 0x6d, 0x96, 0xd0, 0x1b, 0xa8, 0x13, 0x21,
-	0xa8, 0xc4, 0xba, 0x09, 0xac, 0xa3, 0xcb, 0xb6, 0x5d, 0x4e, 0x6c, 0x17, 0x13, 0xdb, 0xd5, 0xc4,
-	0x76, 0x8f, 0xb3, 0xb4, 0xdb, 0xb8, 0xf9, 0xf3, 0xa2, 0x16, 0x94, 0xe8, 0x82, 0x96, 0xe5, 0x2c,
-	0xa2, 0xb8, 0xb9, 0x23, 0x4d, 0xa1, 0x91, 0x0b, 0x9b, 0x42, 0x12, 0x39, 0x17, 0xf8, 0xc0, 0x04,
-	0xd6, 0xc9, 0x65, 0xdb, 0xfe, 0x6f, 0x96, 0x5d, 0x49, 0x0e, 0x15, 0x20, 0xa8, 0x80, 0x85, 0xb0,
-	0xc9, 0xfc, 0x9a, 0xe6, 0xf8, 0x41, 0x29, 0x4c, 0x3d, 0xd0, 0x73, 0x08, 0xa3, 0x9c, 0x12, 0x49,
-	0xe3, 0x31, 0x91, 0xf8, 0xd0, 0x04, 0x56, 0x3d, 0x38, 0xac, 0x2a, 0x9e, 0xec, 0xfc, 0x02, 0xf0,
-	0x74, 0xb0, 0xa0, 0xa9, 0x1c, 0x4a, 0x9a, 0x14, 0xdf, 0xa5, 0xf1, 0xce, 0x4e, 0xae, 0x1d, 0xa9,
-	0xdf, 0xcf, 0x91, 0xc6, 0x5e, 0x8e, 0xdc, 0x15, 0xa2, 0x6f, 0x0b, 0xf9, 0xa1, 0x6d, 0x08, 0xe9,
-	0xf2, 0xf9, 0xf4, 0x8b, 0xdc, 0x27, 0x12, 0xa5, 0x73, 0xf5, 0x4d, 0xe7, 0xd6, 0xf2, 0x1a, 0xf7,
-	0x93, 0xa7, 0xef, 0xf9, 0xc3, 0xeb, 0x9f, 0xe9, 0xce, 0x29, 0x29, 0xb0, 0xa8, 0x0f, 0x8f, 0x4b,
-	0x01, 0x63, 0x92, 0xf0, 0x79, 0x2a, 0x55, 0x54, 0x76, 0x20, 0x3f, 0x2c, 0x59, 0x9e, 0x22, 0x75,
-	0xde, 0xc2, 0x47, 0x6b, 0xdf, 0xfa, 0x74, 0xb6, 0x57, 0x04, 0x5e, 0x11, 0x78, 0x7c, 0x27, 0x8c,
-	0xa8, 0x0d, 0x9f, 0xf8, 0xc3, 0x70, 0x34, 0xbc, 0x7a, 0x37, 0x0e, 0x47, 0xde, 0xe8, 0x43, 0x38,
-	0xf6, 0x7a, 0xa3, 0xe1, 0xc7, 0x41, 0xab, 0x86, 0xce, 0xe1, 0xe3, 0xad, 0x56, 0xf8, 0xde, 0xef,
-	0xb7, 0x00, 0x7a, 0x06, 0xf1, 0x56, 0xa3, 0xe7, 0x5d, 0xf5, 0x06, 0xbe, 0x3f, 0xe8, 0xb7, 0xb4,
-	0xee, 0xcb, 0x9b, 0xa5, 0x01, 0x6e, 0x97, 0x06, 0xf8, 0xbb, 0x34, 0xc0, 0xf7, 0x95, 0x51, 0xbb,
-	0x5d, 0x19, 0xb5, 0xdf, 0x2b, 0xa3, 0xf6, 0xe9, 0xb4, 0x58, 0x2a, 0xdf, 0xd4, 0x6a, 0x91, 0xd7,
-	0x19, 0x15, 0x93, 0xa6, 0xda, 0x0a, 0xaf, 0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0xe3, 0x96, 0xaa,
-	0xa0, 0x72, 0x04, 0x00, 0x00,
+func (m *Listing) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
+	}
+	return nil
 }
 
-func (m *Listing) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Listing) GetRemainingAssets() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RemainingAssets
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Listing) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Listing) GetNft() *NFTAsset {
+	if m != nil {
+		return m.Nft
+	}
+	return nil
 }
 
-func (m *Listing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CreatedAt != 0 {
-		i = encodeVarintMarket(dAtA, i, uint64(m.CreatedAt))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.Buyer) > 0 {
-		i -= len(m.Buyer)
-		copy(dAtA[i:], m.Buyer)
-		i = encodeVarintMarket(dAtA, i, uint64(len(m.Buyer)))
-		i--
-		dAtA[i] = 0x42
+func (m *Listing) GetDeliveryEscrow() bool {
+	if m != nil {
+		return m.DeliveryEscrow
 	}
-	if m.Status != 0 {
-		i = encodeVarintMarket(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x38
+	return false
+}
+
+func (m *Listing) GetEscrowedPayment() types.Coin {
+	if m != nil {
+		return m.EscrowedPayment
 	}
-	{
-		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMarket(dAtA, i, uint64(size))
+	return types.Coin{}
+}
+
+func (m *Listing) GetDeliveryDeadline() int64 {
+	if m != nil {
+		return m.DeliveryDeadline
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMarket(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *Listing) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
 	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintMarket(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *Listing) GetBuyerReferrer() string {
+	if m != nil {
+		return m.BuyerReferrer
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintMarket(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *Listing) GetDeposit() *types.Coin {
+	if m != nil {
+		return m.Deposit
 	}
-	if len(m.Seller) > 0 {
-		i -= len(m.Seller)
-		copy(dAtA[i:], m.Seller)
-		i = encodeVarintMarket(dAtA, i, uint64(len(m.Seller)))
-		i--
-		dAtA[i] = 0x12
+	return nil
+}
+
+func (m *Listing) GetCategory() string {
+	if m != nil {
+		return m.Category
 	}
-	if m.Id != 0 {
-		i = encodeVarintMarket(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *Listing) GetTags() []string {
+	if m != nil {
+		return m.Tags
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventItemListed) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Listing) GetEscrowedFee() types.Coin {
+	if m != nil {
+		return m.EscrowedFee
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *EventItemListed) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PriceChange records a listing's price as of a revision
+type PriceChange struct {
+	Revision    uint64                                   `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	Price       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
+	BlockHeight int64                                    `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockTime   int64                                    `protobuf:"varint,4,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
 }
 
-func (m *EventItemListed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.CreatedAt != 0 {
-		i = encodeVarintMarket(dAtA, i, uint64(m.CreatedAt))
-		i--
-		dAtA[i] = 0x28
-	}
-	{
-		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+func (m *PriceChange) Reset()         { *m = PriceChange{} }
+func (m *PriceChange) String() string { return proto.CompactTextString(m) }
+func (*PriceChange) ProtoMessage()    {}
+func (*PriceChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903d53ef308f134, []int{4}
+}
+func (m *PriceChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMarket(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMarket(dAtA, i, uint64(size))
+}
+func (m *PriceChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceChange.Merge(m, src)
+}
+func (m *PriceChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceChange proto.InternalMessageInfo
+
+func (m *PriceChange) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Seller) > 0 {
-		i -= len(m.Seller)
-		copy(dAtA[i:], m.Seller)
-		i = encodeVarintMarket(dAtA, i, uint64(len(m.Seller)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *PriceChange) GetPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Price
 	}
-	if m.Id != 0 {
-		i = encodeVarintMarket(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *PriceChange) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventItemBought) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PriceChange) GetBlockTime() int64 {
+	if m != nil {
+		return m.BlockTime
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventItemBought) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PricedListing is an open listing with its price per unit of asset in one price denom
+type PricedListing struct {
+	Listing   Listing       `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing"`
+	UnitPrice types.DecCoin `protobuf:"bytes,2,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price"`
 }
 
-func (m *EventItemBought) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.SellerAmount.MarshalToSizedBuffer(dAtA[:i])
+func (m *PricedListing) Reset()         { *m = PricedListing{} }
+func (m *PricedListing) String() string { return proto.CompactTextString(m) }
+func (*PricedListing) ProtoMessage()    {}
+func (*PricedListing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903d53ef308f134, []int{5}
+}
+func (m *PricedListing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PricedListing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PricedListing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMarket(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMarket(dAtA, i, uint64(size))
+}
+func (m *PricedListing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PricedListing.Merge(m, src)
+}
+func (m *PricedListing) XXX_Size() int {
+	return m.Size()
+}
+func (m *PricedListing) XXX_DiscardUnknown() {
+	xxx_messageInfo_PricedListing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PricedListing proto.InternalMessageInfo
+
+func (m *PricedListing) GetListing() Listing {
+	if m != nil {
+		return m.Listing
 	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMarket(dAtA, i, uint64(size))
+	return Listing{}
+}
+
+func (m *PricedListing) GetUnitPrice() types.DecCoin {
+	if m != nil {
+		return m.UnitPrice
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+	return types.DecCoin{}
+}
+
+// FloorPrice is the cheapest unit price of an asset among open listings in one price denom
+type FloorPrice struct {
+	UnitPrice types.DecCoin `protobuf:"bytes,1,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price"`
+	ListingId uint64        `protobuf:"varint,2,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+}
+
+func (m *FloorPrice) Reset()         { *m = FloorPrice{} }
+func (m *FloorPrice) String() string { return proto.CompactTextString(m) }
+func (*FloorPrice) ProtoMessage()    {}
+func (*FloorPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903d53ef308f134, []int{6}
+}
+func (m *FloorPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FloorPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FloorPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintMarket(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Buyer) > 0 {
-		i -= len(m.Buyer)
-		copy(dAtA[i:], m.Buyer)
-		i = encodeVarintMarket(dAtA, i, uint64(len(m.Buyer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Seller) > 0 {
-		i -= len(m.Seller)
-		copy(dAtA[i:], m.Seller)
-		i = encodeVarintMarket(dAtA, i, uint64(len(m.Seller)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintMarket(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *FloorPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FloorPrice.Merge(m, src)
+}
+func (m *FloorPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *FloorPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_FloorPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FloorPrice proto.InternalMessageInfo
+
+func (m *FloorPrice) GetUnitPrice() types.DecCoin {
+	if m != nil {
+		return m.UnitPrice
 	}
-	return len(dAtA) - i, nil
+	return types.DecCoin{}
 }
 
-func (m *EventItemDelisted) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FloorPrice) GetListingId() uint64 {
+	if m != nil {
+		return m.ListingId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventItemDelisted) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Event emitted when an item is listed
+type EventItemListed struct {
+	Id        uint64                                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Seller    string                                   `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	Asset     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=asset,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"asset"`
+	Price     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
+	CreatedAt int64                                    `protobuf:"varint,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Nft       *NFTAsset                                `protobuf:"bytes,6,opt,name=nft,proto3" json:"nft,omitempty"`
+	Category  string                                   `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"`
+	Tags      []string                                 `protobuf:"bytes,8,rep,name=tags,proto3" json:"tags,omitempty"`
 }
 
-func (m *EventItemDelisted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Seller) > 0 {
-		i -= len(m.Seller)
-		copy(dAtA[i:], m.Seller)
-		i = encodeVarintMarket(dAtA, i, uint64(len(m.Seller)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventItemListed) Reset()         { *m = EventItemListed{} }
+func (m *EventItemListed) String() string { return proto.CompactTextString(m) }
+func (*EventItemListed) ProtoMessage()    {}
+func (*EventItemListed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903d53ef308f134, []int{7}
+}
+func (m *EventItemListed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventItemListed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventItemListed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Id != 0 {
-		i = encodeVarintMarket(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *EventItemListed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventItemListed.Merge(m, src)
+}
+func (m *EventItemListed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventItemListed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventItemListed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventItemListed proto.InternalMessageInfo
+
+func (m *EventItemListed) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintMarket(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMarket(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventItemListed) GetSeller() string {
+	if m != nil {
+		return m.Seller
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Listing) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EventItemListed) GetAsset() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Asset
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovMarket(uint64(m.Id))
+	return nil
+}
+
+func (m *EventItemListed) GetPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Price
 	}
-	l = len(m.Seller)
-	if l > 0 {
-		n += 1 + l + sovMarket(uint64(l))
+	return nil
+}
+
+func (m *EventItemListed) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
 	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovMarket(uint64(l))
+	return 0
+}
+
+func (m *EventItemListed) GetNft() *NFTAsset {
+	if m != nil {
+		return m.Nft
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovMarket(uint64(l))
+	return nil
+}
+
+func (m *EventItemListed) GetCategory() string {
+	if m != nil {
+		return m.Category
 	}
-	l = m.Asset.Size()
-	n += 1 + l + sovMarket(uint64(l))
-	l = m.Price.Size()
-	n += 1 + l + sovMarket(uint64(l))
-	if m.Status != 0 {
-		n += 1 + sovMarket(uint64(m.Status))
+	return ""
+}
+
+func (m *EventItemListed) GetTags() []string {
+	if m != nil {
+		return m.Tags
 	}
-	l = len(m.Buyer)
-	if l > 0 {
-		n += 1 + l + sovMarket(uint64(l))
+	return nil
+}
+
+// Event emitted when an item is bought
+type EventItemBought struct {
+	Id           uint64                                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Seller       string                                   `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	Buyer        string                                   `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Asset        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=asset,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"asset"`
+	Price        types.Coin                               `protobuf:"bytes,5,opt,name=price,proto3" json:"price"`
+	Fee          types.Coin                               `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee"`
+	SellerAmount types.Coin                               `protobuf:"bytes,7,opt,name=seller_amount,json=sellerAmount,proto3" json:"seller_amount"`
+	Quantity     cosmossdk_io_math.Int                    `protobuf:"bytes,8,opt,name=quantity,proto3,customtype=cosmossdk.io/math.Int" json:"quantity"`
+	Nft          *NFTAsset                                `protobuf:"bytes,9,opt,name=nft,proto3" json:"nft,omitempty"`
+	// royalties paid out of the price to creators; empty for delivery escrow sales, which pay them on release
+	Royalties []RoyaltyPayment `protobuf:"bytes,10,rep,name=royalties,proto3" json:"royalties"`
+	// referrals paid out of fee; empty for delivery escrow sales, which pay them on release
+	Referrals []ReferralPayment `protobuf:"bytes,11,rep,name=referrals,proto3" json:"referrals"`
+}
+
+func (m *EventItemBought) Reset()         { *m = EventItemBought{} }
+func (m *EventItemBought) String() string { return proto.CompactTextString(m) }
+func (*EventItemBought) ProtoMessage()    {}
+func (*EventItemBought) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903d53ef308f134, []int{8}
+}
+func (m *EventItemBought) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventItemBought) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventItemBought.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.CreatedAt != 0 {
-		n += 1 + sovMarket(uint64(m.CreatedAt))
+}
+func (m *EventItemBought) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventItemBought.Merge(m, src)
+}
+func (m *EventItemBought) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventItemBought) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventItemBought.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventItemBought proto.InternalMessageInfo
+
+func (m *EventItemBought) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return n
+	return 0
 }
 
-func (m *EventItemListed) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventItemBought) GetSeller() string {
+	if m != nil {
+		return m.Seller
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovMarket(uint64(m.Id))
+	return ""
+}
+
+func (m *EventItemBought) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
 	}
-	l = len(m.Seller)
-	if l > 0 {
-		n += 1 + l + sovMarket(uint64(l))
+	return ""
+}
+
+func (m *EventItemBought) GetAsset() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Asset
 	}
-	l = m.Asset.Size()
-	n += 1 + l + sovMarket(uint64(l))
-	l = m.Price.Size()
-	n += 1 + l + sovMarket(uint64(l))
-	if m.CreatedAt != 0 {
-		n += 1 + sovMarket(uint64(m.CreatedAt))
+	return nil
+}
+
+func (m *EventItemBought) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
 	}
-	return n
+	return types.Coin{}
 }
 
-func (m *EventItemBought) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventItemBought) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovMarket(uint64(m.Id))
+	return types.Coin{}
+}
+
+func (m *EventItemBought) GetSellerAmount() types.Coin {
+	if m != nil {
+		return m.SellerAmount
 	}
-	l = len(m.Seller)
-	if l > 0 {
-		n += 1 + l + sovMarket(uint64(l))
+	return types.Coin{}
+}
+
+func (m *EventItemBought) GetNft() *NFTAsset {
+	if m != nil {
+		return m.Nft
 	}
-	l = len(m.Buyer)
-	if l > 0 {
-		n += 1 + l + sovMarket(uint64(l))
+	return nil
+}
+
+func (m *EventItemBought) GetRoyalties() []RoyaltyPayment {
+	if m != nil {
+		return m.Royalties
 	}
-	l = m.Asset.Size()
-	n += 1 + l + sovMarket(uint64(l))
-	l = m.Price.Size()
-	n += 1 + l + sovMarket(uint64(l))
-	l = m.Fee.Size()
-	n += 1 + l + sovMarket(uint64(l))
-	l = m.SellerAmount.Size()
-	n += 1 + l + sovMarket(uint64(l))
-	return n
+	return nil
 }
 
-func (m *EventItemDelisted) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventItemBought) GetReferrals() []ReferralPayment {
+	if m != nil {
+		return m.Referrals
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovMarket(uint64(m.Id))
+	return nil
+}
+
+// Event emitted when an item is delisted
+type EventItemDelisted struct {
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+}
+
+func (m *EventItemDelisted) Reset()         { *m = EventItemDelisted{} }
+func (m *EventItemDelisted) String() string { return proto.CompactTextString(m) }
+func (*EventItemDelisted) ProtoMessage()    {}
+func (*EventItemDelisted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903d53ef308f134, []int{9}
+}
+func (m *EventItemDelisted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventItemDelisted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventItemDelisted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Seller)
-	if l > 0 {
-		n += 1 + l + sovMarket(uint64(l))
+}
+func (m *EventItemDelisted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventItemDelisted.Merge(m, src)
+}
+func (m *EventItemDelisted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventItemDelisted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventItemDelisted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventItemDelisted proto.InternalMessageInfo
+
+func (m *EventItemDelisted) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return n
+	return 0
 }
 
-func sovMarket(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *EventItemDelisted) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
 }
-func sozMarket(x uint64) (n int) {
-	return sovMarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+// Event emitted when a seller updates an active listing
+type EventItemUpdated struct {
+	Id       uint64                                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Seller   string                                   `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	Asset    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=asset,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"asset"`
+	Price    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=price,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"price"`
+	Revision uint64                                   `protobuf:"varint,5,opt,name=revision,proto3" json:"revision,omitempty"`
+}
+
+func (m *EventItemUpdated) Reset()         { *m = EventItemUpdated{} }
+func (m *EventItemUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventItemUpdated) ProtoMessage()    {}
+func (*EventItemUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903d53ef308f134, []int{10}
+}
+func (m *EventItemUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventItemUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventItemUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventItemUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventItemUpdated.Merge(m, src)
+}
+func (m *EventItemUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventItemUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventItemUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventItemUpdated proto.InternalMessageInfo
+
+func (m *EventItemUpdated) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventItemUpdated) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *EventItemUpdated) GetAsset() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Asset
+	}
+	return nil
+}
+
+func (m *EventItemUpdated) GetPrice() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Price
+	}
+	return nil
+}
+
+func (m *EventItemUpdated) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+// Event emitted when a listing expires and its asset is returned to the seller
+type EventItemExpired struct {
+	Id        uint64                                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Seller    string                                   `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	Asset     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=asset,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"asset"`
+	ExpiresAt int64                                    `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (m *EventItemExpired) Reset()         { *m = EventItemExpired{} }
+func (m *EventItemExpired) String() string { return proto.CompactTextString(m) }
+func (*EventItemExpired) ProtoMessage()    {}
+func (*EventItemExpired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903d53ef308f134, []int{11}
+}
+func (m *EventItemExpired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventItemExpired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventItemExpired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventItemExpired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventItemExpired.Merge(m, src)
+}
+func (m *EventItemExpired) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventItemExpired) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventItemExpired.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventItemExpired proto.InternalMessageInfo
+
+func (m *EventItemExpired) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventItemExpired) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *EventItemExpired) GetAsset() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Asset
+	}
+	return nil
+}
+
+func (m *EventItemExpired) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+// Event emitted when the module authority takes down an open listing
+type EventItemTakenDown struct {
+	Id     uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Seller string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventItemTakenDown) Reset()         { *m = EventItemTakenDown{} }
+func (m *EventItemTakenDown) String() string { return proto.CompactTextString(m) }
+func (*EventItemTakenDown) ProtoMessage()    {}
+func (*EventItemTakenDown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903d53ef308f134, []int{12}
+}
+func (m *EventItemTakenDown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventItemTakenDown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventItemTakenDown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventItemTakenDown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventItemTakenDown.Merge(m, src)
+}
+func (m *EventItemTakenDown) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventItemTakenDown) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventItemTakenDown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventItemTakenDown proto.InternalMessageInfo
+
+func (m *EventItemTakenDown) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventItemTakenDown) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *EventItemTakenDown) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// Event emitted when a closed listing's deposit is refunded to the seller or forfeited to
+// the community pool
+type EventListingDepositSettled struct {
+	Id        uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Seller    string     `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	Deposit   types.Coin `protobuf:"bytes,3,opt,name=deposit,proto3" json:"deposit"`
+	Forfeited bool       `protobuf:"varint,4,opt,name=forfeited,proto3" json:"forfeited,omitempty"`
+}
+
+func (m *EventListingDepositSettled) Reset()         { *m = EventListingDepositSettled{} }
+func (m *EventListingDepositSettled) String() string { return proto.CompactTextString(m) }
+func (*EventListingDepositSettled) ProtoMessage()    {}
+func (*EventListingDepositSettled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903d53ef308f134, []int{13}
+}
+func (m *EventListingDepositSettled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventListingDepositSettled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventListingDepositSettled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventListingDepositSettled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventListingDepositSettled.Merge(m, src)
+}
+func (m *EventListingDepositSettled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventListingDepositSettled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventListingDepositSettled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventListingDepositSettled proto.InternalMessageInfo
+
+func (m *EventListingDepositSettled) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventListingDepositSettled) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *EventListingDepositSettled) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return types.Coin{}
+}
+
+func (m *EventListingDepositSettled) GetForfeited() bool {
+	if m != nil {
+		return m.Forfeited
+	}
+	return false
+}
+
+// Event emitted when the escrowed payment of a delivery escrow sale is released to the seller
+type EventDeliveryReleased struct {
+	Id           uint64            `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Seller       string            `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	Buyer        string            `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Price        types.Coin        `protobuf:"bytes,4,opt,name=price,proto3" json:"price"`
+	Fee          types.Coin        `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee"`
+	SellerAmount types.Coin        `protobuf:"bytes,6,opt,name=seller_amount,json=sellerAmount,proto3" json:"seller_amount"`
+	TimedOut     bool              `protobuf:"varint,7,opt,name=timed_out,json=timedOut,proto3" json:"timed_out,omitempty"`
+	Royalties    []RoyaltyPayment  `protobuf:"bytes,8,rep,name=royalties,proto3" json:"royalties"`
+	Referrals    []ReferralPayment `protobuf:"bytes,9,rep,name=referrals,proto3" json:"referrals"`
+}
+
+func (m *EventDeliveryReleased) Reset()         { *m = EventDeliveryReleased{} }
+func (m *EventDeliveryReleased) String() string { return proto.CompactTextString(m) }
+func (*EventDeliveryReleased) ProtoMessage()    {}
+func (*EventDeliveryReleased) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903d53ef308f134, []int{14}
+}
+func (m *EventDeliveryReleased) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDeliveryReleased) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDeliveryReleased.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDeliveryReleased) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDeliveryReleased.Merge(m, src)
+}
+func (m *EventDeliveryReleased) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDeliveryReleased) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDeliveryReleased.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDeliveryReleased proto.InternalMessageInfo
+
+func (m *EventDeliveryReleased) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventDeliveryReleased) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *EventDeliveryReleased) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *EventDeliveryReleased) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *EventDeliveryReleased) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
+func (m *EventDeliveryReleased) GetSellerAmount() types.Coin {
+	if m != nil {
+		return m.SellerAmount
+	}
+	return types.Coin{}
+}
+
+func (m *EventDeliveryReleased) GetTimedOut() bool {
+	if m != nil {
+		return m.TimedOut
+	}
+	return false
+}
+
+func (m *EventDeliveryReleased) GetRoyalties() []RoyaltyPayment {
+	if m != nil {
+		return m.Royalties
+	}
+	return nil
+}
+
+func (m *EventDeliveryReleased) GetReferrals() []ReferralPayment {
+	if m != nil {
+		return m.Referrals
+	}
+	return nil
+}
+
+// BuyQuote is what buying a listing costs in the current block and how the payment is split.
+type BuyQuote struct {
+	ListingId uint64 `protobuf:"varint,1,opt,name=listing_id,json=listingId,proto3" json:"listing_id,omitempty"`
+	// revision of the listing the quote was computed from.
+	Revision uint64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// asset is what the buyer receives.
+	Asset github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=asset,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"asset"`
+	// total is what the buyer pays; the fee and royalties are taken out of it, not added on top.
+	Total        types.Coin       `protobuf:"bytes,4,opt,name=total,proto3" json:"total"`
+	Fee          types.Coin       `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee"`
+	SellerAmount types.Coin       `protobuf:"bytes,6,opt,name=seller_amount,json=sellerAmount,proto3" json:"seller_amount"`
+	Royalties    []RoyaltyPayment `protobuf:"bytes,7,rep,name=royalties,proto3" json:"royalties"`
+}
+
+func (m *BuyQuote) Reset()         { *m = BuyQuote{} }
+func (m *BuyQuote) String() string { return proto.CompactTextString(m) }
+func (*BuyQuote) ProtoMessage()    {}
+func (*BuyQuote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7903d53ef308f134, []int{15}
+}
+func (m *BuyQuote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BuyQuote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BuyQuote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BuyQuote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuyQuote.Merge(m, src)
+}
+func (m *BuyQuote) XXX_Size() int {
+	return m.Size()
+}
+func (m *BuyQuote) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuyQuote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuyQuote proto.InternalMessageInfo
+
+func (m *BuyQuote) GetListingId() uint64 {
+	if m != nil {
+		return m.ListingId
+	}
+	return 0
+}
+
+func (m *BuyQuote) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *BuyQuote) GetAsset() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Asset
+	}
+	return nil
+}
+
+func (m *BuyQuote) GetTotal() types.Coin {
+	if m != nil {
+		return m.Total
+	}
+	return types.Coin{}
+}
+
+func (m *BuyQuote) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
+func (m *BuyQuote) GetSellerAmount() types.Coin {
+	if m != nil {
+		return m.SellerAmount
+	}
+	return types.Coin{}
+}
+
+func (m *BuyQuote) GetRoyalties() []RoyaltyPayment {
+	if m != nil {
+		return m.Royalties
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("amp.amp.v1.ListingStatus", ListingStatus_name, ListingStatus_value)
+	proto.RegisterEnum("amp.amp.v1.ListingAccess", ListingAccess_name, ListingAccess_value)
+	proto.RegisterEnum("amp.amp.v1.DecayUnit", DecayUnit_name, DecayUnit_value)
+	proto.RegisterType((*ListingRestriction)(nil), "amp.amp.v1.ListingRestriction")
+	proto.RegisterType((*NFTAsset)(nil), "amp.amp.v1.NFTAsset")
+	proto.RegisterType((*DutchAuction)(nil), "amp.amp.v1.DutchAuction")
+	proto.RegisterType((*Listing)(nil), "amp.amp.v1.Listing")
+	proto.RegisterType((*PriceChange)(nil), "amp.amp.v1.PriceChange")
+	proto.RegisterType((*PricedListing)(nil), "amp.amp.v1.PricedListing")
+	proto.RegisterType((*FloorPrice)(nil), "amp.amp.v1.FloorPrice")
+	proto.RegisterType((*EventItemListed)(nil), "amp.amp.v1.EventItemListed")
+	proto.RegisterType((*EventItemBought)(nil), "amp.amp.v1.EventItemBought")
+	proto.RegisterType((*EventItemDelisted)(nil), "amp.amp.v1.EventItemDelisted")
+	proto.RegisterType((*EventItemUpdated)(nil), "amp.amp.v1.EventItemUpdated")
+	proto.RegisterType((*EventItemExpired)(nil), "amp.amp.v1.EventItemExpired")
+	proto.RegisterType((*EventItemTakenDown)(nil), "amp.amp.v1.EventItemTakenDown")
+	proto.RegisterType((*EventListingDepositSettled)(nil), "amp.amp.v1.EventListingDepositSettled")
+	proto.RegisterType((*EventDeliveryReleased)(nil), "amp.amp.v1.EventDeliveryReleased")
+	proto.RegisterType((*BuyQuote)(nil), "amp.amp.v1.BuyQuote")
+}
+
+func init() { proto.RegisterFile("amp/amp/v1/market.proto", fileDescriptor_7903d53ef308f134) }
+
+var fileDescriptor_7903d53ef308f134 = []byte{
+	// 1716 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcb, 0x73, 0x1b, 0x49,
+	0x19, 0xf7, 0xe8, 0xad, 0x4f, 0x7e, 0x4c, 0x3a, 0xb1, 0x33, 0x76, 0xb2, 0xb6, 0x57, 0xcb, 0xc3,
+	0x9b, 0xad, 0x95, 0xf1, 0xa6, 0x72, 0xe0, 0x51, 0xc0, 0x48, 0x33, 0x5e, 0x66, 0xa3, 0xb5, 0xcd,
+	0x48, 0x4e, 0x08, 0x97, 0xa9, 0xb6, 0xa6, 0x25, 0x4f, 0x79, 0x34, 0x23, 0xa6, 0x5b, 0xce, 0x8a,
+	0x13, 0x37, 0xae, 0x1c, 0x39, 0xc0, 0x05, 0x6e, 0x5c, 0xb8, 0x70, 0xe4, 0x0f, 0xd8, 0x13, 0xb5,
+	0x45, 0x71, 0x00, 0x0e, 0x0b, 0x95, 0xfc, 0x0f, 0x9c, 0xa9, 0x7e, 0x8c, 0x2c, 0x0d, 0x59, 0xa2,
+	0x24, 0xb8, 0xa0, 0x8a, 0x83, 0x4a, 0xd3, 0xbf, 0xef, 0xd1, 0xdd, 0xdf, 0xf7, 0xf5, 0xef, 0xeb,
+	0x19, 0xb8, 0x8d, 0x87, 0xa3, 0x7d, 0xfe, 0xbb, 0x3c, 0xd8, 0x1f, 0xe2, 0xe4, 0x82, 0xb0, 0xc6,
+	0x28, 0x89, 0x59, 0x8c, 0x00, 0x0f, 0x47, 0x0d, 0xfe, 0xbb, 0x3c, 0xd8, 0xda, 0x9c, 0x51, 0x4a,
+	0x48, 0x9f, 0x24, 0x09, 0x0e, 0xa5, 0xda, 0x96, 0x31, 0x2b, 0x8a, 0x27, 0x38, 0x64, 0x13, 0x25,
+	0xd9, 0xee, 0xc5, 0x74, 0x18, 0xd3, 0xfd, 0x33, 0x4c, 0xc9, 0xfe, 0xe5, 0xc1, 0x19, 0x61, 0xf8,
+	0x60, 0xbf, 0x17, 0x07, 0x91, 0x92, 0x6f, 0x4a, 0xb9, 0x27, 0x46, 0xfb, 0x72, 0xa0, 0x44, 0xb7,
+	0x06, 0xf1, 0x20, 0x96, 0x38, 0x7f, 0x92, 0x68, 0xfd, 0xc7, 0x80, 0xda, 0x01, 0x65, 0x41, 0x34,
+	0x70, 0x09, 0x65, 0x49, 0xd0, 0x63, 0x41, 0x1c, 0xa1, 0x2f, 0xc3, 0x6a, 0x42, 0x28, 0x49, 0x2e,
+	0x89, 0xef, 0x9d, 0x8d, 0x27, 0x24, 0x31, 0xb4, 0x5d, 0x6d, 0xaf, 0xea, 0xae, 0xa4, 0x68, 0x93,
+	0x83, 0xe8, 0x2e, 0x54, 0x71, 0x18, 0xc6, 0x4f, 0xc3, 0x80, 0x32, 0x23, 0xb7, 0x9b, 0xdf, 0xab,
+	0xba, 0x57, 0x00, 0xda, 0x81, 0xda, 0x90, 0x24, 0x17, 0x21, 0xf1, 0x92, 0x38, 0x66, 0x46, 0x7e,
+	0x57, 0xdb, 0x5b, 0x76, 0x41, 0x42, 0x6e, 0x1c, 0xb3, 0xfa, 0xb7, 0xa0, 0x72, 0x74, 0xd8, 0x35,
+	0x29, 0x25, 0x0c, 0x6d, 0x42, 0xa5, 0x17, 0x62, 0x4a, 0xbd, 0xc0, 0x57, 0x73, 0x95, 0xc5, 0xd8,
+	0xf1, 0xd1, 0x3a, 0x94, 0xa2, 0x3e, 0xe3, 0x82, 0x9c, 0x10, 0x14, 0xa3, 0x3e, 0x73, 0xfc, 0xfa,
+	0x5f, 0x34, 0x58, 0xb6, 0xc6, 0xac, 0x77, 0x6e, 0x8e, 0xe5, 0xa2, 0xbf, 0x0b, 0xb5, 0x7e, 0x18,
+	0xc7, 0x89, 0x37, 0x4a, 0x82, 0x1e, 0x11, 0x5e, 0x6a, 0x1f, 0x6c, 0x36, 0x54, 0x10, 0x78, 0xc4,
+	0x1a, 0x2a, 0x62, 0x8d, 0x56, 0x1c, 0x44, 0xcd, 0xc2, 0xa7, 0x9f, 0xef, 0x2c, 0xb9, 0x20, 0x6c,
+	0x4e, 0xb8, 0x09, 0x7a, 0x17, 0x0a, 0xe3, 0x28, 0x60, 0x62, 0x9e, 0xd5, 0x0f, 0xd6, 0x1b, 0x57,
+	0xd9, 0x6a, 0x58, 0xa4, 0x87, 0x27, 0xa7, 0x51, 0xc0, 0x5c, 0xa1, 0x82, 0xb6, 0xa0, 0xe2, 0x8f,
+	0x13, 0xcc, 0x27, 0x16, 0x3b, 0x2b, 0xb8, 0xd3, 0x31, 0x7a, 0x1b, 0x96, 0x29, 0xc3, 0x09, 0xf3,
+	0xce, 0x49, 0x30, 0x38, 0x67, 0x46, 0x61, 0x57, 0xdb, 0xcb, 0xbb, 0x35, 0x81, 0x7d, 0x4f, 0x40,
+	0xe8, 0x2d, 0x00, 0xa9, 0xc2, 0x82, 0x21, 0x31, 0x8a, 0x42, 0xa1, 0x2a, 0x90, 0x6e, 0x30, 0x24,
+	0xf5, 0xdf, 0x56, 0xa1, 0xac, 0xd2, 0x82, 0x56, 0x21, 0xa7, 0x62, 0x52, 0x70, 0x73, 0x81, 0x8f,
+	0x36, 0xa0, 0x44, 0x49, 0x18, 0x92, 0x44, 0x85, 0x43, 0x8d, 0xd0, 0x2d, 0x28, 0xb2, 0x80, 0x85,
+	0x44, 0x2c, 0xa7, 0xea, 0xca, 0x01, 0xda, 0x85, 0x9a, 0x4f, 0x68, 0x2f, 0x09, 0x46, 0x62, 0xa9,
+	0x05, 0x21, 0x9b, 0x85, 0x10, 0x86, 0x22, 0xe6, 0x29, 0x30, 0x8a, 0xbb, 0xf9, 0x7f, 0x1f, 0xb0,
+	0xaf, 0xf1, 0x80, 0xfd, 0xe6, 0x6f, 0x3b, 0x7b, 0x83, 0x80, 0x9d, 0x8f, 0xcf, 0x1a, 0xbd, 0x78,
+	0xa8, 0x4a, 0x4c, 0xfd, 0xbd, 0x4f, 0xfd, 0x8b, 0x7d, 0x36, 0x19, 0x11, 0x2a, 0x0c, 0xa8, 0x2b,
+	0x3d, 0xf3, 0x29, 0x64, 0x4e, 0x4a, 0xd7, 0x30, 0x85, 0xf0, 0x8c, 0x0e, 0xa0, 0x44, 0x19, 0x66,
+	0x63, 0x6a, 0x94, 0x45, 0xf2, 0x36, 0x67, 0x93, 0xa7, 0x42, 0xd9, 0x11, 0x0a, 0xae, 0x52, 0xe4,
+	0x01, 0x93, 0xb5, 0x5d, 0x91, 0x01, 0x13, 0x03, 0x9e, 0x99, 0x5e, 0x42, 0x30, 0x23, 0xbe, 0x87,
+	0x99, 0x51, 0x95, 0x99, 0x51, 0x88, 0xc9, 0x50, 0x03, 0x8a, 0x3e, 0x2f, 0x3a, 0x03, 0x44, 0x79,
+	0x19, 0x73, 0x35, 0x32, 0x53, 0x8d, 0xae, 0x54, 0xe3, 0xee, 0xc8, 0x27, 0xa3, 0x20, 0x21, 0x94,
+	0xbb, 0xab, 0x49, 0x77, 0x0a, 0x31, 0x19, 0x7a, 0x07, 0x56, 0x46, 0x38, 0x61, 0x01, 0x0e, 0xbd,
+	0x7e, 0x10, 0x86, 0xd4, 0x58, 0xde, 0xd5, 0xf6, 0x2a, 0xee, 0xb2, 0x02, 0x0f, 0x39, 0xc6, 0x6b,
+	0x2d, 0x21, 0x97, 0x01, 0xe5, 0x09, 0x5c, 0x95, 0xb5, 0x96, 0x8e, 0xf9, 0xbe, 0x71, 0xaf, 0x47,
+	0x28, 0x35, 0xd6, 0xbe, 0x70, 0xdf, 0xa6, 0x50, 0x70, 0x95, 0x62, 0xf6, 0x5c, 0xea, 0xd9, 0x73,
+	0x89, 0x2e, 0x41, 0x4f, 0xc8, 0x10, 0x07, 0x51, 0x10, 0x0d, 0x3c, 0x91, 0x41, 0x6a, 0xdc, 0xf8,
+	0xcf, 0x67, 0x6e, 0x6d, 0x3a, 0x89, 0xa0, 0x00, 0x8a, 0xbe, 0x02, 0xf9, 0xa8, 0xcf, 0x0c, 0x24,
+	0x22, 0x7b, 0x6b, 0x76, 0x23, 0x29, 0x4d, 0xb8, 0x5c, 0x01, 0x7d, 0x15, 0xd6, 0x7c, 0x12, 0x06,
+	0x97, 0x24, 0x99, 0x78, 0xbc, 0x90, 0xe3, 0xa7, 0xc6, 0x4d, 0x11, 0xb6, 0xd5, 0x14, 0xb6, 0x05,
+	0x8a, 0x3e, 0x02, 0x5d, 0xca, 0x89, 0xef, 0x8d, 0xf0, 0x64, 0x48, 0x22, 0x66, 0xdc, 0x5a, 0x8c,
+	0x16, 0xd6, 0x52, 0xc3, 0x13, 0x69, 0x87, 0xde, 0x83, 0x1b, 0xd3, 0x49, 0x7d, 0x82, 0xfd, 0x30,
+	0x88, 0x88, 0xb1, 0x2e, 0xf2, 0xa9, 0xa7, 0x02, 0x4b, 0xe1, 0x32, 0x63, 0x9c, 0xd2, 0x49, 0x62,
+	0x6c, 0x88, 0xea, 0x9a, 0x8e, 0x39, 0xb7, 0x8a, 0x4a, 0xf3, 0xa6, 0x1a, 0xb7, 0x25, 0xb7, 0x0a,
+	0xd4, 0x4d, 0xd5, 0xee, 0x43, 0xd9, 0x27, 0xa3, 0x98, 0x06, 0xcc, 0x30, 0x5e, 0xb2, 0x64, 0x37,
+	0xd5, 0xe4, 0xf3, 0xf6, 0x30, 0x23, 0x83, 0x38, 0x99, 0x18, 0x9b, 0x72, 0xde, 0x74, 0x8c, 0x10,
+	0x14, 0x18, 0x1e, 0x50, 0x63, 0x4b, 0xf0, 0xb4, 0x78, 0x46, 0x4d, 0x58, 0x9e, 0x06, 0xa8, 0x4f,
+	0x88, 0x71, 0x67, 0xb1, 0xe0, 0xd4, 0x52, 0xa3, 0x43, 0x42, 0x3e, 0x2a, 0x54, 0x56, 0xf4, 0xd5,
+	0xfa, 0x1f, 0x34, 0xa8, 0x09, 0x12, 0x6d, 0x9d, 0xe3, 0x68, 0x40, 0xe6, 0x6a, 0x56, 0xcb, 0xd4,
+	0xec, 0x94, 0x0e, 0x72, 0xd7, 0x46, 0x07, 0x6f, 0xc3, 0xf2, 0x59, 0x18, 0xf7, 0x2e, 0x52, 0x0a,
+	0xce, 0x4b, 0x0a, 0x16, 0xd8, 0x15, 0x05, 0x4b, 0x15, 0x41, 0xc1, 0x92, 0xa3, 0xab, 0x02, 0x11,
+	0x14, 0xfc, 0x53, 0x0d, 0x56, 0xc4, 0x86, 0xfc, 0x94, 0x88, 0xef, 0x43, 0x39, 0x94, 0x8f, 0xaa,
+	0xb7, 0xdc, 0x7c, 0xc1, 0x59, 0x53, 0x11, 0x4a, 0x35, 0x91, 0x09, 0xc0, 0xfb, 0x85, 0x97, 0x6e,
+	0x98, 0xdb, 0xdd, 0x7d, 0xe1, 0x86, 0x2d, 0xd2, 0x9b, 0x09, 0x71, 0x95, 0x5b, 0x89, 0xf9, 0xeb,
+	0x11, 0xc0, 0xe1, 0x55, 0x8f, 0x9a, 0x77, 0xa8, 0xbd, 0x86, 0x43, 0xbe, 0x73, 0xb5, 0xbc, 0xb4,
+	0xa9, 0x16, 0xdc, 0xaa, 0x42, 0x1c, 0xbf, 0xfe, 0x8f, 0x1c, 0xac, 0xd9, 0x97, 0x24, 0x62, 0x0e,
+	0x23, 0x43, 0xbe, 0x2d, 0xe2, 0x2f, 0xdc, 0x84, 0xa6, 0xcd, 0x24, 0x7f, 0xfd, 0xcd, 0xa4, 0x70,
+	0x6d, 0xd5, 0x33, 0xdf, 0x03, 0x8a, 0xd9, 0x1e, 0xa0, 0x78, 0xaa, 0xf4, 0x32, 0x9e, 0x9a, 0x3d,
+	0x8d, 0xe5, 0x2f, 0x38, 0x8d, 0x95, 0xab, 0xd3, 0x58, 0xff, 0x53, 0x61, 0x26, 0xf0, 0xcd, 0x78,
+	0xcc, 0xab, 0xf4, 0x15, 0xba, 0xbf, 0x6c, 0x66, 0xf9, 0xd9, 0x66, 0x36, 0x4d, 0x47, 0xe1, 0xda,
+	0xd2, 0xf1, 0x20, 0x4d, 0x47, 0x71, 0x31, 0xee, 0x98, 0xf6, 0xeb, 0x3c, 0x27, 0x9c, 0xd2, 0x62,
+	0x46, 0x5c, 0x17, 0x59, 0xb0, 0x22, 0x37, 0xeb, 0xe1, 0x61, 0x3c, 0x8e, 0x98, 0x51, 0x5e, 0xcc,
+	0x78, 0x59, 0x5a, 0x99, 0xc2, 0x08, 0x7d, 0x08, 0x95, 0x1f, 0x8d, 0x71, 0xc4, 0x02, 0x36, 0x91,
+	0x8d, 0xbf, 0xf9, 0x1e, 0xd7, 0xfa, 0xeb, 0xe7, 0x3b, 0xeb, 0xd2, 0x0f, 0xf5, 0x2f, 0x1a, 0x41,
+	0xbc, 0x3f, 0xc4, 0xec, 0xbc, 0xe1, 0x44, 0xec, 0x8f, 0xbf, 0x7b, 0x1f, 0xd4, 0x04, 0x4e, 0xc4,
+	0xdc, 0xa9, 0x71, 0x5a, 0x05, 0xd5, 0x97, 0x55, 0xc1, 0xb7, 0xa1, 0x2a, 0xef, 0xf0, 0x01, 0xa1,
+	0x06, 0x88, 0x3c, 0x6c, 0xcd, 0x6a, 0xbb, 0x42, 0x38, 0x51, 0x7d, 0x26, 0x3d, 0xad, 0x53, 0x13,
+	0xf4, 0x1d, 0xa8, 0xa6, 0xaf, 0x07, 0xd4, 0xa8, 0x09, 0xfb, 0x3b, 0x73, 0xf6, 0x4a, 0x98, 0x75,
+	0x90, 0xda, 0xd4, 0xbf, 0x09, 0x37, 0xa6, 0x55, 0x65, 0x91, 0xf0, 0x95, 0x0e, 0x74, 0xfd, 0x17,
+	0x39, 0xd0, 0xa7, 0xd6, 0xa7, 0x23, 0x1f, 0xff, 0xff, 0xb1, 0xc1, 0x6c, 0x2b, 0x2b, 0xce, 0xb7,
+	0xb2, 0xfa, 0xef, 0xb5, 0x99, 0xf0, 0xd8, 0xe2, 0x5a, 0xf7, 0x3f, 0x15, 0x9e, 0xf9, 0xeb, 0x67,
+	0x21, 0x73, 0xfd, 0xac, 0x77, 0x01, 0x4d, 0x57, 0xdf, 0xc5, 0x17, 0x24, 0xb2, 0xe2, 0xa7, 0xd1,
+	0xc2, 0xeb, 0xdf, 0x80, 0x52, 0x42, 0x30, 0x55, 0x6f, 0x40, 0x55, 0x57, 0x8d, 0xea, 0xbf, 0xd4,
+	0x60, 0x4b, 0xb8, 0x55, 0x3d, 0xd1, 0x92, 0xb7, 0x93, 0x0e, 0x61, 0x2c, 0x7c, 0x85, 0xf0, 0x7c,
+	0xfd, 0xea, 0x06, 0x94, 0x5f, 0xec, 0xa4, 0xa7, 0xfa, 0xfc, 0xc5, 0xb4, 0x1f, 0x27, 0x7d, 0x12,
+	0x30, 0xe2, 0x8b, 0x5d, 0x57, 0xdc, 0x2b, 0xa0, 0xfe, 0xeb, 0x3c, 0xac, 0x8b, 0xf5, 0x59, 0xea,
+	0xde, 0xe6, 0x92, 0x90, 0x60, 0x4a, 0xfc, 0x37, 0x64, 0xdb, 0x07, 0x57, 0xb5, 0xf8, 0x1a, 0x54,
+	0x58, 0x7c, 0x13, 0x2a, 0x2c, 0xbd, 0x0e, 0x15, 0xde, 0x81, 0x2a, 0xbf, 0xfb, 0xf8, 0x5e, 0x3c,
+	0x96, 0x64, 0x5a, 0x71, 0x2b, 0x02, 0x38, 0x1e, 0x67, 0x68, 0xab, 0xf2, 0x86, 0xb4, 0x55, 0x7d,
+	0x0d, 0xda, 0xfa, 0x79, 0x1e, 0x2a, 0xcd, 0xf1, 0xe4, 0xfb, 0xe3, 0x98, 0x65, 0xaf, 0x2c, 0x5a,
+	0xe6, 0xca, 0x32, 0x77, 0x44, 0x73, 0xff, 0x7a, 0xdb, 0xbc, 0xee, 0x53, 0xf6, 0x00, 0x8a, 0x2c,
+	0x66, 0x38, 0x5c, 0x38, 0xf1, 0x42, 0xfb, 0xbf, 0x97, 0xf8, 0xb9, 0xdc, 0x96, 0x5f, 0x39, 0xb7,
+	0xf7, 0x7e, 0x95, 0x83, 0x95, 0xb9, 0x77, 0x6a, 0xb4, 0x09, 0xeb, 0x6d, 0xa7, 0xd3, 0x75, 0x8e,
+	0x3e, 0xf4, 0x3a, 0x5d, 0xb3, 0x7b, 0xda, 0xf1, 0xcc, 0x56, 0xd7, 0x79, 0x64, 0xeb, 0x4b, 0xe8,
+	0x36, 0xdc, 0xcc, 0x88, 0x3a, 0xc7, 0x6d, 0x4b, 0xd7, 0xd0, 0x5d, 0x30, 0x32, 0x82, 0x96, 0x79,
+	0xd4, 0xb2, 0xdb, 0x6d, 0xdb, 0xd2, 0x73, 0x68, 0x0b, 0x36, 0x32, 0x52, 0xfb, 0x07, 0x27, 0x8e,
+	0x6b, 0x5b, 0x7a, 0x1e, 0xbd, 0x03, 0x3b, 0x19, 0xd9, 0x89, 0xe9, 0x76, 0x1d, 0xb3, 0xdd, 0x7e,
+	0xe2, 0x1d, 0x3a, 0xc2, 0x41, 0x01, 0x7d, 0x09, 0x76, 0xb3, 0x4b, 0x7a, 0x6c, 0x3a, 0x62, 0x6c,
+	0xd9, 0x6d, 0xe7, 0x91, 0xed, 0x3e, 0xd1, 0x8b, 0x2f, 0x5a, 0xc4, 0xf1, 0xc7, 0x27, 0x6d, 0xbb,
+	0x6b, 0x5b, 0x7a, 0x09, 0xdd, 0x81, 0xdb, 0x19, 0xa9, 0xe5, 0x74, 0x4e, 0x4e, 0xb9, 0xb0, 0x8c,
+	0xde, 0x82, 0xcd, 0x8c, 0xb0, 0x6b, 0x3e, 0xb4, 0x8f, 0x3c, 0xeb, 0xf8, 0xf1, 0x91, 0x5e, 0xb9,
+	0xf7, 0x13, 0x0d, 0x56, 0xe6, 0x5e, 0xc0, 0x67, 0x83, 0x64, 0xb6, 0x5a, 0x76, 0xa7, 0xe3, 0x9d,
+	0x9c, 0x36, 0xdb, 0x4e, 0x4b, 0x5f, 0x9a, 0x9d, 0x48, 0x89, 0x5c, 0xbb, 0x63, 0xbb, 0x8f, 0xec,
+	0x4c, 0xa0, 0x94, 0xd0, 0x6c, 0xb7, 0x8f, 0x1f, 0x73, 0x4c, 0xcf, 0xbd, 0xc0, 0xeb, 0xc7, 0xb6,
+	0xfb, 0xb0, 0x6d, 0xeb, 0xf9, 0x7b, 0xdf, 0x80, 0xea, 0xf4, 0xbb, 0x15, 0xda, 0x00, 0x64, 0xd9,
+	0x2d, 0xf3, 0x89, 0x77, 0x7a, 0xe4, 0x74, 0xbd, 0x8e, 0xdd, 0x3a, 0x3e, 0xb2, 0x3a, 0xfa, 0x12,
+	0x5a, 0x87, 0x1b, 0x33, 0x78, 0xb3, 0x7d, 0xdc, 0x7a, 0xd8, 0xd1, 0xb5, 0xe6, 0xbb, 0x9f, 0x3e,
+	0xdb, 0xd6, 0x3e, 0x7b, 0xb6, 0xad, 0xfd, 0xfd, 0xd9, 0xb6, 0xf6, 0xb3, 0xe7, 0xdb, 0x4b, 0x9f,
+	0x3d, 0xdf, 0x5e, 0xfa, 0xf3, 0xf3, 0xed, 0xa5, 0x1f, 0xae, 0xf1, 0x2f, 0x93, 0x9f, 0x88, 0xef,
+	0x93, 0xe2, 0x2c, 0x9c, 0x95, 0xc4, 0xa7, 0xc4, 0xfb, 0xff, 0x1c, 0x00, 0xe3, 0x80, 0xf6, 0x4b,
+	0xf7, 0x14, 0x00, 0x00,
+}
+
+func (m *ListingRestriction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListingRestriction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListingRestriction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintMarket(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ReservedBuyer) > 0 {
+		i -= len(m.ReservedBuyer)
+		copy(dAtA[i:], m.ReservedBuyer)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.ReservedBuyer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NFTAsset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NFTAsset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NFTAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DutchAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DutchAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DutchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StartTime != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Duration != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Unit != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Unit))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.FloorPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Listing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Listing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Listing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.EscrowedFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xda
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintMarket(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.Deposit != nil {
+		{
+			size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMarket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc2
+	}
+	if len(m.BuyerReferrer) > 0 {
+		i -= len(m.BuyerReferrer)
+		copy(dAtA[i:], m.BuyerReferrer)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.BuyerReferrer)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.DeliveryDeadline != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.DeliveryDeadline))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	{
+		size, err := m.EscrowedPayment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	if m.DeliveryEscrow {
+		i--
+		if m.DeliveryEscrow {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.Nft != nil {
+		{
+			size, err := m.Nft.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMarket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.RemainingAssets) > 0 {
+		for iNdEx := len(m.RemainingAssets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemainingAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Access != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Access))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.Revision != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.PartialFills {
+		i--
+		if m.PartialFills {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ExpiresAt != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Dutch != nil {
+		{
+			size, err := m.Dutch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMarket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Status != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Asset) > 0 {
+		for iNdEx := len(m.Asset) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Asset[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockTime != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.BlockTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Revision != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PricedListing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PricedListing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PricedListing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.UnitPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Listing.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FloorPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FloorPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FloorPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ListingId != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.ListingId))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.UnitPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventItemListed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventItemListed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventItemListed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintMarket(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Nft != nil {
+		{
+			size, err := m.Nft.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMarket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CreatedAt != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Asset) > 0 {
+		for iNdEx := len(m.Asset) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Asset[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventItemBought) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventItemBought) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventItemBought) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Referrals) > 0 {
+		for iNdEx := len(m.Referrals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Referrals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.Royalties) > 0 {
+		for iNdEx := len(m.Royalties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Royalties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Nft != nil {
+		{
+			size, err := m.Nft.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMarket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.SellerAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Asset) > 0 {
+		for iNdEx := len(m.Asset) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Asset[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventItemDelisted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventItemDelisted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventItemDelisted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventItemUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventItemUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventItemUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Asset) > 0 {
+		for iNdEx := len(m.Asset) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Asset[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventItemExpired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventItemExpired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventItemExpired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Asset) > 0 {
+		for iNdEx := len(m.Asset) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Asset[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventItemTakenDown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventItemTakenDown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventItemTakenDown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventListingDepositSettled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventListingDepositSettled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventListingDepositSettled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Forfeited {
+		i--
+		if m.Forfeited {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDeliveryReleased) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDeliveryReleased) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDeliveryReleased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Referrals) > 0 {
+		for iNdEx := len(m.Referrals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Referrals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Royalties) > 0 {
+		for iNdEx := len(m.Royalties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Royalties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.TimedOut {
+		i--
+		if m.TimedOut {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.SellerAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintMarket(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BuyQuote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuyQuote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuyQuote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Royalties) > 0 {
+		for iNdEx := len(m.Royalties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Royalties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.SellerAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Asset) > 0 {
+		for iNdEx := len(m.Asset) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Asset[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMarket(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Revision != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ListingId != 0 {
+		i = encodeVarintMarket(dAtA, i, uint64(m.ListingId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMarket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMarket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ListingRestriction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ReservedBuyer)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	return n
+}
+
+func (m *NFTAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	return n
+}
+
+func (m *DutchAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FloorPrice.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if m.Unit != 0 {
+		n += 1 + sovMarket(uint64(m.Unit))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovMarket(uint64(m.Duration))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovMarket(uint64(m.StartHeight))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovMarket(uint64(m.StartTime))
+	}
+	return n
+}
+
+func (m *Listing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMarket(uint64(m.Id))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if len(m.Asset) > 0 {
+		for _, e := range m.Asset {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovMarket(uint64(m.Status))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovMarket(uint64(m.CreatedAt))
+	}
+	if m.Dutch != nil {
+		l = m.Dutch.Size()
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovMarket(uint64(m.ExpiresAt))
+	}
+	if m.PartialFills {
+		n += 2
+	}
+	if m.Revision != 0 {
+		n += 1 + sovMarket(uint64(m.Revision))
+	}
+	if m.Access != 0 {
+		n += 1 + sovMarket(uint64(m.Access))
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 2 + l + sovMarket(uint64(l))
+	}
+	if len(m.RemainingAssets) > 0 {
+		for _, e := range m.RemainingAssets {
+			l = e.Size()
+			n += 2 + l + sovMarket(uint64(l))
+		}
+	}
+	if m.Nft != nil {
+		l = m.Nft.Size()
+		n += 2 + l + sovMarket(uint64(l))
+	}
+	if m.DeliveryEscrow {
+		n += 3
+	}
+	l = m.EscrowedPayment.Size()
+	n += 2 + l + sovMarket(uint64(l))
+	if m.DeliveryDeadline != 0 {
+		n += 2 + sovMarket(uint64(m.DeliveryDeadline))
+	}
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 2 + l + sovMarket(uint64(l))
+	}
+	l = len(m.BuyerReferrer)
+	if l > 0 {
+		n += 2 + l + sovMarket(uint64(l))
+	}
+	if m.Deposit != nil {
+		l = m.Deposit.Size()
+		n += 2 + l + sovMarket(uint64(l))
+	}
+	l = len(m.Category)
+	if l > 0 {
+		n += 2 + l + sovMarket(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 2 + l + sovMarket(uint64(l))
+		}
+	}
+	l = m.EscrowedFee.Size()
+	n += 2 + l + sovMarket(uint64(l))
+	return n
+}
+
+func (m *PriceChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Revision != 0 {
+		n += 1 + sovMarket(uint64(m.Revision))
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovMarket(uint64(m.BlockHeight))
+	}
+	if m.BlockTime != 0 {
+		n += 1 + sovMarket(uint64(m.BlockTime))
+	}
+	return n
+}
+
+func (m *PricedListing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Listing.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.UnitPrice.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	return n
+}
+
+func (m *FloorPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UnitPrice.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if m.ListingId != 0 {
+		n += 1 + sovMarket(uint64(m.ListingId))
+	}
+	return n
+}
+
+func (m *EventItemListed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMarket(uint64(m.Id))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if len(m.Asset) > 0 {
+		for _, e := range m.Asset {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovMarket(uint64(m.CreatedAt))
+	}
+	if m.Nft != nil {
+		l = m.Nft.Size()
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventItemBought) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMarket(uint64(m.Id))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if len(m.Asset) > 0 {
+		for _, e := range m.Asset {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.SellerAmount.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.Quantity.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if m.Nft != nil {
+		l = m.Nft.Size()
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if len(m.Royalties) > 0 {
+		for _, e := range m.Royalties {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	if len(m.Referrals) > 0 {
+		for _, e := range m.Referrals {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventItemDelisted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMarket(uint64(m.Id))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	return n
+}
+
+func (m *EventItemUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMarket(uint64(m.Id))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if len(m.Asset) > 0 {
+		for _, e := range m.Asset {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	if m.Revision != 0 {
+		n += 1 + sovMarket(uint64(m.Revision))
+	}
+	return n
+}
+
+func (m *EventItemExpired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMarket(uint64(m.Id))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	if len(m.Asset) > 0 {
+		for _, e := range m.Asset {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovMarket(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
+func (m *EventItemTakenDown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMarket(uint64(m.Id))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	return n
+}
+
+func (m *EventListingDepositSettled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMarket(uint64(m.Id))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if m.Forfeited {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventDeliveryReleased) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMarket(uint64(m.Id))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovMarket(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.SellerAmount.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if m.TimedOut {
+		n += 2
+	}
+	if len(m.Royalties) > 0 {
+		for _, e := range m.Royalties {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	if len(m.Referrals) > 0 {
+		for _, e := range m.Referrals {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BuyQuote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ListingId != 0 {
+		n += 1 + sovMarket(uint64(m.ListingId))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovMarket(uint64(m.Revision))
+	}
+	if len(m.Asset) > 0 {
+		for _, e := range m.Asset {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	l = m.SellerAmount.Size()
+	n += 1 + l + sovMarket(uint64(l))
+	if len(m.Royalties) > 0 {
+		for _, e := range m.Royalties {
+			l = e.Size()
+			n += 1 + l + sovMarket(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovMarket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMarket(x uint64) (n int) {
+	return sovMarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ListingRestriction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListingRestriction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListingRestriction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedBuyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReservedBuyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NFTAsset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NFTAsset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NFTAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DutchAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DutchAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DutchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FloorPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+			}
+			m.Unit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Unit |= DecayUnit(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Listing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Listing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Listing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = append(m.Asset, types.Coin{})
+			if err := m.Asset[len(m.Asset)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ListingStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dutch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Dutch == nil {
+				m.Dutch = &DutchAuction{}
+			}
+			if err := m.Dutch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialFills", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PartialFills = bool(v != 0)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Access", wireType)
+			}
+			m.Access = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Access |= ListingAccess(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingAssets = append(m.RemainingAssets, types.Coin{})
+			if err := m.RemainingAssets[len(m.RemainingAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nft", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Nft == nil {
+				m.Nft = &NFTAsset{}
+			}
+			if err := m.Nft.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryEscrow", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeliveryEscrow = bool(v != 0)
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedPayment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EscrowedPayment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryDeadline", wireType)
+			}
+			m.DeliveryDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeliveryDeadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerReferrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuyerReferrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Deposit == nil {
+				m.Deposit = &types.Coin{}
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Category = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EscrowedFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			m.BlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PricedListing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PricedListing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PricedListing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Listing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Listing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FloorPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FloorPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FloorPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListingId", wireType)
+			}
+			m.ListingId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ListingId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventItemListed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventItemListed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventItemListed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = append(m.Asset, types.Coin{})
+			if err := m.Asset[len(m.Asset)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nft", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Nft == nil {
+				m.Nft = &NFTAsset{}
+			}
+			if err := m.Nft.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Category = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventItemBought) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventItemBought: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventItemBought: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = append(m.Asset, types.Coin{})
+			if err := m.Asset[len(m.Asset)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellerAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SellerAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nft", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Nft == nil {
+				m.Nft = &NFTAsset{}
+			}
+			if err := m.Nft.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Royalties = append(m.Royalties, RoyaltyPayment{})
+			if err := m.Royalties[len(m.Royalties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrals = append(m.Referrals, ReferralPayment{})
+			if err := m.Referrals[len(m.Referrals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventItemDelisted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventItemDelisted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventItemDelisted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Listing) Unmarshal(dAtA []byte) error {
+func (m *EventItemUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -839,10 +5765,10 @@ func (m *Listing) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Listing: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventItemUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Listing: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventItemUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -898,9 +5824,9 @@ func (m *Listing) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -910,29 +5836,31 @@ func (m *Listing) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMarket
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Asset = append(m.Asset, types.Coin{})
+			if err := m.Asset[len(m.Asset)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -942,29 +5870,31 @@ func (m *Listing) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMarket
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
 			}
-			var msglen int
+			m.Revision = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -974,30 +5904,66 @@ func (m *Listing) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Revision |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMarket
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMarket
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventItemExpired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventItemExpired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventItemExpired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -1007,30 +5973,16 @@ func (m *Listing) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthMarket
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMarket
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -1040,16 +5992,29 @@ func (m *Listing) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ListingStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -1059,29 +6024,31 @@ func (m *Listing) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMarket
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Buyer = string(dAtA[iNdEx:postIndex])
+			m.Asset = append(m.Asset, types.Coin{})
+			if err := m.Asset[len(m.Asset)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
-			m.CreatedAt = 0
+			m.ExpiresAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -1091,7 +6058,7 @@ func (m *Listing) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAt |= int64(b&0x7F) << shift
+				m.ExpiresAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1117,7 +6084,7 @@ func (m *Listing) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventItemListed) Unmarshal(dAtA []byte) error {
+func (m *EventItemTakenDown) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1140,10 +6107,10 @@ func (m *EventItemListed) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventItemListed: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventItemTakenDown: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventItemListed: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventItemTakenDown: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1195,13 +6162,114 @@ func (m *EventItemListed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Seller = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventListingDepositSettled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventListingDepositSettled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventListingDepositSettled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -1211,28 +6279,27 @@ func (m *EventItemListed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMarket
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1259,15 +6326,15 @@ func (m *EventItemListed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Forfeited", wireType)
 			}
-			m.CreatedAt = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -1277,11 +6344,12 @@ func (m *EventItemListed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreatedAt |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Forfeited = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMarket(dAtA[iNdEx:])
@@ -1303,7 +6371,7 @@ func (m *EventItemListed) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventItemBought) Unmarshal(dAtA []byte) error {
+func (m *EventDeliveryReleased) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1326,10 +6394,10 @@ func (m *EventItemBought) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventItemBought: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDeliveryReleased: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventItemBought: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDeliveryReleased: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1417,7 +6485,7 @@ func (m *EventItemBought) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1444,13 +6512,13 @@ func (m *EventItemBought) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1477,13 +6545,13 @@ func (m *EventItemBought) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SellerAmount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1510,13 +6578,33 @@ func (m *EventItemBought) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SellerAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimedOut", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TimedOut = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SellerAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalties", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1543,7 +6631,42 @@ func (m *EventItemBought) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SellerAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Royalties = append(m.Royalties, RoyaltyPayment{})
+			if err := m.Royalties[len(m.Royalties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrals = append(m.Referrals, ReferralPayment{})
+			if err := m.Referrals[len(m.Referrals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1568,7 +6691,7 @@ func (m *EventItemBought) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventItemDelisted) Unmarshal(dAtA []byte) error {
+func (m *BuyQuote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1591,17 +6714,17 @@ func (m *EventItemDelisted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventItemDelisted: wiretype end group for non-group")
+			return fmt.Errorf("proto: BuyQuote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventItemDelisted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BuyQuote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListingId", wireType)
 			}
-			m.Id = 0
+			m.ListingId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -1611,16 +6734,35 @@ func (m *EventItemDelisted) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.ListingId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMarket
@@ -1630,23 +6772,158 @@ func (m *EventItemDelisted) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMarket
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMarket
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Seller = string(dAtA[iNdEx:postIndex])
+			m.Asset = append(m.Asset, types.Coin{})
+			if err := m.Asset[len(m.Asset)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellerAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SellerAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Royalties = append(m.Royalties, RoyaltyPayment{})
+			if err := m.Royalties[len(m.Royalties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
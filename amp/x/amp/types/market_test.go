@@ -0,0 +1,86 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"amp/x/amp/types"
+)
+
+func TestListingPriceAt(t *testing.T) {
+	fixed := types.Listing{Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 1000))}
+	require.Equal(t, fixed.Price, fixed.PriceAt(100, 100))
+
+	bySeconds := types.Listing{
+		Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)),
+		Dutch: &types.DutchAuction{
+			FloorPrice: sdk.NewInt64Coin("stake", 400),
+			Unit:       types.DecayUnit_DECAY_UNIT_SECONDS,
+			Duration:   60,
+			StartTime:  1000,
+		},
+	}
+	require.Equal(t, int64(1000), bySeconds.PriceAt(0, 1000).AmountOf("stake").Int64())
+	require.Equal(t, int64(700), bySeconds.PriceAt(0, 1030).AmountOf("stake").Int64())
+	require.Equal(t, int64(400), bySeconds.PriceAt(0, 1060).AmountOf("stake").Int64())
+	require.Equal(t, int64(400), bySeconds.PriceAt(0, 5000).AmountOf("stake").Int64())
+
+	byBlocks := types.Listing{
+		Price: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		Dutch: &types.DutchAuction{
+			FloorPrice:  sdk.NewInt64Coin("stake", 0),
+			Unit:        types.DecayUnit_DECAY_UNIT_BLOCKS,
+			Duration:    3,
+			StartHeight: 10,
+		},
+	}
+	// decay is rounded down: 100 - floor(100*1/3)
+	require.Equal(t, int64(67), byBlocks.PriceAt(11, 0).AmountOf("stake").Int64())
+	require.True(t, byBlocks.PriceAt(13, 0).AmountOf("stake").IsZero())
+}
+
+func TestDutchAuctionValidate(t *testing.T) {
+	start := sdk.NewInt64Coin("stake", 100)
+	valid := types.DutchAuction{FloorPrice: sdk.NewInt64Coin("stake", 10), Duration: 10}
+	require.NoError(t, valid.Validate(start))
+
+	wrongDenom := valid
+	wrongDenom.FloorPrice = sdk.NewInt64Coin("token", 10)
+	require.ErrorIs(t, wrongDenom.Validate(start), types.ErrInvalidDutchAuction)
+
+	aboveStart := valid
+	aboveStart.FloorPrice = sdk.NewInt64Coin("stake", 101)
+	require.ErrorIs(t, aboveStart.Validate(start), types.ErrInvalidDutchAuction)
+
+	noDuration := valid
+	noDuration.Duration = 0
+	require.ErrorIs(t, noDuration.Validate(start), types.ErrInvalidDutchAuction)
+}
+
+func TestValidateListingTerms(t *testing.T) {
+	bundle := sdk.NewCoins(sdk.NewInt64Coin("gold", 1), sdk.NewInt64Coin("silver", 5))
+	options := sdk.NewCoins(sdk.NewInt64Coin("stake", 5000), sdk.NewInt64Coin("token", 100))
+	require.NoError(t, types.ValidateListingTerms(bundle, options, nil, false, nil))
+
+	require.Error(t, types.ValidateListingTerms(sdk.Coins{}, options, nil, false, nil))
+	require.Error(t, types.ValidateListingTerms(bundle, sdk.Coins{}, nil, false, nil))
+	require.ErrorIs(t, types.ValidateListingTerms(bundle, options, nil, true, nil), types.ErrInvalidQuantity)
+
+	nft := &types.NFTAsset{ClassId: "art", NftId: "1"}
+	require.NoError(t, types.ValidateListingTerms(sdk.Coins{}, options, nil, false, nft))
+	require.ErrorIs(t, types.ValidateListingTerms(bundle, options, nil, false, nft), types.ErrInvalidNFT)
+	require.ErrorIs(t, types.ValidateListingTerms(sdk.Coins{}, options, nil, false, &types.NFTAsset{ClassId: "art"}), types.ErrInvalidNFT)
+
+	dutch := &types.DutchAuction{FloorPrice: sdk.NewInt64Coin("stake", 10), Duration: 10}
+	require.ErrorIs(t, types.ValidateListingTerms(bundle, options, dutch, false, nil), types.ErrInvalidDutchAuction)
+
+	price, err := types.PriceIn(options, "token")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("token", 100), price)
+	_, err = types.PriceIn(options, "")
+	require.ErrorIs(t, err, types.ErrInvalidDenom)
+	_, err = types.PriceIn(options, "atom")
+	require.ErrorIs(t, err, types.ErrInvalidDenom)
+}
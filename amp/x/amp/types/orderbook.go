@@ -0,0 +1,78 @@
+package types
+
+import (
+    errorsmod "cosmossdk.io/errors"
+    sdkmath "cosmossdk.io/math"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultDepthLimit is the number of price levels per side returned when a depth query
+// does not set a limit, and MaxDepthLimit the most it may ask for.
+const (
+    DefaultDepthLimit uint32 = 20
+    MaxDepthLimit     uint32 = 100
+)
+
+// OrderBook returns the key of the book trading assetDenom for priceDenom. Denoms cannot
+// contain a comma, so distinct pairs never share a key.
+func OrderBook(assetDenom, priceDenom string) string {
+    return assetDenom + "," + priceDenom
+}
+
+// ValidateOrder checks the terms of a new limit order. Unit prices must fit in a uint64 so
+// that the book can be kept sorted by price.
+func ValidateOrder(side OrderSide, assetDenom string, unitPrice sdk.Coin, quantity sdkmath.Int) error {
+    if side != OrderSide_ORDER_SIDE_BUY && side != OrderSide_ORDER_SIDE_SELL {
+        return errorsmod.Wrapf(ErrInvalidOrder, "unknown side %s", side)
+    }
+    if err := sdk.ValidateDenom(assetDenom); err != nil {
+        return errorsmod.Wrap(ErrInvalidOrder, err.Error())
+    }
+    if err := unitPrice.Validate(); err != nil {
+        return errorsmod.Wrap(ErrInvalidOrder, err.Error())
+    }
+    if !unitPrice.IsPositive() || !unitPrice.Amount.IsUint64() {
+        return errorsmod.Wrap(ErrInvalidOrder, "unit price must be positive and fit in a uint64")
+    }
+    if unitPrice.Denom == assetDenom {
+        return errorsmod.Wrap(ErrInvalidOrder, "asset and price denoms must differ")
+    }
+    if quantity.IsNil() || !quantity.IsPositive() {
+        return errorsmod.Wrap(ErrInvalidOrder, "quantity must be positive")
+    }
+    return nil
+}
+
+// Book returns the key of the book the order trades on.
+func (o Order) Book() string {
+    return OrderBook(o.AssetDenom, o.UnitPrice.Denom)
+}
+
+// Escrowed returns what the order keeps in escrow for amount of its quantity: the asset
+// itself for sell orders, and amount times the unit price for buy orders.
+func (o Order) Escrowed(amount sdkmath.Int) (sdk.Coin, error) {
+    if o.Side == OrderSide_ORDER_SIDE_SELL {
+        return sdk.NewCoin(o.AssetDenom, amount), nil
+    }
+    total, err := o.UnitPrice.Amount.SafeMul(amount)
+    if err != nil {
+        return sdk.Coin{}, errorsmod.Wrap(ErrInvalidOrder, err.Error())
+    }
+    return sdk.NewCoin(o.UnitPrice.Denom, total), nil
+}
+
+// Crosses reports whether a resting order at makerPrice can fill the order.
+func (o Order) Crosses(makerPrice sdkmath.Int) bool {
+    if o.Side == OrderSide_ORDER_SIDE_BUY {
+        return makerPrice.LTE(o.UnitPrice.Amount)
+    }
+    return makerPrice.GTE(o.UnitPrice.Amount)
+}
+
+// Opposite returns the side an order on side matches against.
+func (s OrderSide) Opposite() OrderSide {
+    if s == OrderSide_ORDER_SIDE_BUY {
+        return OrderSide_ORDER_SIDE_SELL
+    }
+    return OrderSide_ORDER_SIDE_BUY
+}
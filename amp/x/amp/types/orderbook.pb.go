@@ -0,0 +1,2176 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: amp/amp/v1/orderbook.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// OrderSide is the side of the book a limit order rests on
+type OrderSide int32
+
+const (
+	OrderSide_ORDER_SIDE_UNSPECIFIED OrderSide = 0
+	OrderSide_ORDER_SIDE_BUY         OrderSide = 1
+	OrderSide_ORDER_SIDE_SELL        OrderSide = 2
+)
+
+var OrderSide_name = map[int32]string{
+	0: "ORDER_SIDE_UNSPECIFIED",
+	1: "ORDER_SIDE_BUY",
+	2: "ORDER_SIDE_SELL",
+}
+
+var OrderSide_value = map[string]int32{
+	"ORDER_SIDE_UNSPECIFIED": 0,
+	"ORDER_SIDE_BUY":         1,
+	"ORDER_SIDE_SELL":        2,
+}
+
+func (x OrderSide) String() string {
+	return proto.EnumName(OrderSide_name, int32(x))
+}
+
+func (OrderSide) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ae1908c57232c218, []int{0}
+}
+
+// OrderStatus represents the state of a limit order
+type OrderStatus int32
+
+const (
+	OrderStatus_ORDER_STATUS_OPEN      OrderStatus = 0
+	OrderStatus_ORDER_STATUS_FILLED    OrderStatus = 1
+	OrderStatus_ORDER_STATUS_CANCELLED OrderStatus = 2
+)
+
+var OrderStatus_name = map[int32]string{
+	0: "ORDER_STATUS_OPEN",
+	1: "ORDER_STATUS_FILLED",
+	2: "ORDER_STATUS_CANCELLED",
+}
+
+var OrderStatus_value = map[string]int32{
+	"ORDER_STATUS_OPEN":      0,
+	"ORDER_STATUS_FILLED":    1,
+	"ORDER_STATUS_CANCELLED": 2,
+}
+
+func (x OrderStatus) String() string {
+	return proto.EnumName(OrderStatus_name, int32(x))
+}
+
+func (OrderStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_ae1908c57232c218, []int{1}
+}
+
+// Order is a limit order to trade asset_denom for the denom of unit_price. Sell orders
+// escrow the remaining asset, buy orders the remaining quantity times unit_price.
+type Order struct {
+	Id         uint64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner      string    `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Side       OrderSide `protobuf:"varint,3,opt,name=side,proto3,enum=amp.amp.v1.OrderSide" json:"side,omitempty"`
+	AssetDenom string    `protobuf:"bytes,4,opt,name=asset_denom,json=assetDenom,proto3" json:"asset_denom,omitempty"`
+	// unit_price is the price of one unit of asset_denom; buy orders pay at most, sell
+	// orders receive at least this much.
+	UnitPrice types.Coin            `protobuf:"bytes,5,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price"`
+	Quantity  cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=quantity,proto3,customtype=cosmossdk.io/math.Int" json:"quantity"`
+	Remaining cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=remaining,proto3,customtype=cosmossdk.io/math.Int" json:"remaining"`
+	Status    OrderStatus           `protobuf:"varint,8,opt,name=status,proto3,enum=amp.amp.v1.OrderStatus" json:"status,omitempty"`
+	CreatedAt int64                 `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (m *Order) Reset()         { *m = Order{} }
+func (m *Order) String() string { return proto.CompactTextString(m) }
+func (*Order) ProtoMessage()    {}
+func (*Order) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae1908c57232c218, []int{0}
+}
+func (m *Order) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Order) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Order.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Order) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Order.Merge(m, src)
+}
+func (m *Order) XXX_Size() int {
+	return m.Size()
+}
+func (m *Order) XXX_DiscardUnknown() {
+	xxx_messageInfo_Order.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Order proto.InternalMessageInfo
+
+func (m *Order) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Order) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Order) GetSide() OrderSide {
+	if m != nil {
+		return m.Side
+	}
+	return OrderSide_ORDER_SIDE_UNSPECIFIED
+}
+
+func (m *Order) GetAssetDenom() string {
+	if m != nil {
+		return m.AssetDenom
+	}
+	return ""
+}
+
+func (m *Order) GetUnitPrice() types.Coin {
+	if m != nil {
+		return m.UnitPrice
+	}
+	return types.Coin{}
+}
+
+func (m *Order) GetStatus() OrderStatus {
+	if m != nil {
+		return m.Status
+	}
+	return OrderStatus_ORDER_STATUS_OPEN
+}
+
+func (m *Order) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+// PriceLevel aggregates the open orders on one side of a book at one unit price
+type PriceLevel struct {
+	UnitPrice types.Coin            `protobuf:"bytes,1,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price"`
+	Quantity  cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=quantity,proto3,customtype=cosmossdk.io/math.Int" json:"quantity"`
+	Orders    uint32                `protobuf:"varint,3,opt,name=orders,proto3" json:"orders,omitempty"`
+}
+
+func (m *PriceLevel) Reset()         { *m = PriceLevel{} }
+func (m *PriceLevel) String() string { return proto.CompactTextString(m) }
+func (*PriceLevel) ProtoMessage()    {}
+func (*PriceLevel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae1908c57232c218, []int{1}
+}
+func (m *PriceLevel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceLevel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceLevel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceLevel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceLevel.Merge(m, src)
+}
+func (m *PriceLevel) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceLevel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceLevel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceLevel proto.InternalMessageInfo
+
+func (m *PriceLevel) GetUnitPrice() types.Coin {
+	if m != nil {
+		return m.UnitPrice
+	}
+	return types.Coin{}
+}
+
+func (m *PriceLevel) GetOrders() uint32 {
+	if m != nil {
+		return m.Orders
+	}
+	return 0
+}
+
+// Event emitted when a limit order is placed
+type EventOrderPlaced struct {
+	Id         uint64                `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner      string                `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Side       OrderSide             `protobuf:"varint,3,opt,name=side,proto3,enum=amp.amp.v1.OrderSide" json:"side,omitempty"`
+	AssetDenom string                `protobuf:"bytes,4,opt,name=asset_denom,json=assetDenom,proto3" json:"asset_denom,omitempty"`
+	UnitPrice  types.Coin            `protobuf:"bytes,5,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price"`
+	Quantity   cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=quantity,proto3,customtype=cosmossdk.io/math.Int" json:"quantity"`
+}
+
+func (m *EventOrderPlaced) Reset()         { *m = EventOrderPlaced{} }
+func (m *EventOrderPlaced) String() string { return proto.CompactTextString(m) }
+func (*EventOrderPlaced) ProtoMessage()    {}
+func (*EventOrderPlaced) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae1908c57232c218, []int{2}
+}
+func (m *EventOrderPlaced) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOrderPlaced) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOrderPlaced.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOrderPlaced) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderPlaced.Merge(m, src)
+}
+func (m *EventOrderPlaced) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOrderPlaced) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderPlaced.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOrderPlaced proto.InternalMessageInfo
+
+func (m *EventOrderPlaced) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventOrderPlaced) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventOrderPlaced) GetSide() OrderSide {
+	if m != nil {
+		return m.Side
+	}
+	return OrderSide_ORDER_SIDE_UNSPECIFIED
+}
+
+func (m *EventOrderPlaced) GetAssetDenom() string {
+	if m != nil {
+		return m.AssetDenom
+	}
+	return ""
+}
+
+func (m *EventOrderPlaced) GetUnitPrice() types.Coin {
+	if m != nil {
+		return m.UnitPrice
+	}
+	return types.Coin{}
+}
+
+// Event emitted for every match between a resting (maker) and an incoming (taker) order
+type EventOrderFilled struct {
+	MakerOrderId uint64     `protobuf:"varint,1,opt,name=maker_order_id,json=makerOrderId,proto3" json:"maker_order_id,omitempty"`
+	TakerOrderId uint64     `protobuf:"varint,2,opt,name=taker_order_id,json=takerOrderId,proto3" json:"taker_order_id,omitempty"`
+	Buyer        string     `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Seller       string     `protobuf:"bytes,4,opt,name=seller,proto3" json:"seller,omitempty"`
+	Asset        types.Coin `protobuf:"bytes,5,opt,name=asset,proto3" json:"asset"`
+	// price is what the buyer paid for asset, at the maker's unit price
+	Price types.Coin `protobuf:"bytes,6,opt,name=price,proto3" json:"price"`
+	Fee   types.Coin `protobuf:"bytes,7,opt,name=fee,proto3" json:"fee"`
+	// royalties paid out of price to creators
+	Royalties []RoyaltyPayment `protobuf:"bytes,8,rep,name=royalties,proto3" json:"royalties"`
+}
+
+func (m *EventOrderFilled) Reset()         { *m = EventOrderFilled{} }
+func (m *EventOrderFilled) String() string { return proto.CompactTextString(m) }
+func (*EventOrderFilled) ProtoMessage()    {}
+func (*EventOrderFilled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae1908c57232c218, []int{3}
+}
+func (m *EventOrderFilled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOrderFilled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOrderFilled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOrderFilled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderFilled.Merge(m, src)
+}
+func (m *EventOrderFilled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOrderFilled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderFilled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOrderFilled proto.InternalMessageInfo
+
+func (m *EventOrderFilled) GetMakerOrderId() uint64 {
+	if m != nil {
+		return m.MakerOrderId
+	}
+	return 0
+}
+
+func (m *EventOrderFilled) GetTakerOrderId() uint64 {
+	if m != nil {
+		return m.TakerOrderId
+	}
+	return 0
+}
+
+func (m *EventOrderFilled) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *EventOrderFilled) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *EventOrderFilled) GetAsset() types.Coin {
+	if m != nil {
+		return m.Asset
+	}
+	return types.Coin{}
+}
+
+func (m *EventOrderFilled) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *EventOrderFilled) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
+func (m *EventOrderFilled) GetRoyalties() []RoyaltyPayment {
+	if m != nil {
+		return m.Royalties
+	}
+	return nil
+}
+
+// Event emitted when an open order is cancelled by its owner
+type EventOrderCancelled struct {
+	Id     uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner  string     `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Refund types.Coin `protobuf:"bytes,3,opt,name=refund,proto3" json:"refund"`
+}
+
+func (m *EventOrderCancelled) Reset()         { *m = EventOrderCancelled{} }
+func (m *EventOrderCancelled) String() string { return proto.CompactTextString(m) }
+func (*EventOrderCancelled) ProtoMessage()    {}
+func (*EventOrderCancelled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae1908c57232c218, []int{4}
+}
+func (m *EventOrderCancelled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOrderCancelled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOrderCancelled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOrderCancelled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderCancelled.Merge(m, src)
+}
+func (m *EventOrderCancelled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOrderCancelled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderCancelled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOrderCancelled proto.InternalMessageInfo
+
+func (m *EventOrderCancelled) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *EventOrderCancelled) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventOrderCancelled) GetRefund() types.Coin {
+	if m != nil {
+		return m.Refund
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterEnum("amp.amp.v1.OrderSide", OrderSide_name, OrderSide_value)
+	proto.RegisterEnum("amp.amp.v1.OrderStatus", OrderStatus_name, OrderStatus_value)
+	proto.RegisterType((*Order)(nil), "amp.amp.v1.Order")
+	proto.RegisterType((*PriceLevel)(nil), "amp.amp.v1.PriceLevel")
+	proto.RegisterType((*EventOrderPlaced)(nil), "amp.amp.v1.EventOrderPlaced")
+	proto.RegisterType((*EventOrderFilled)(nil), "amp.amp.v1.EventOrderFilled")
+	proto.RegisterType((*EventOrderCancelled)(nil), "amp.amp.v1.EventOrderCancelled")
+}
+
+func init() { proto.RegisterFile("amp/amp/v1/orderbook.proto", fileDescriptor_ae1908c57232c218) }
+
+var fileDescriptor_ae1908c57232c218 = []byte{
+	// 712 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0x9d, 0x9f, 0xd6, 0x13, 0x48, 0xc3, 0xf6, 0xcf, 0x8d, 0x44, 0x1a, 0x45, 0x1c, 0xd2,
+	0x22, 0x6c, 0xa5, 0x08, 0x71, 0xab, 0xd4, 0x24, 0x2e, 0xb2, 0x14, 0xb5, 0x91, 0xd3, 0x1e, 0xca,
+	0xc5, 0xda, 0xc4, 0xdb, 0x62, 0xd5, 0xf6, 0x06, 0x7b, 0x13, 0xc8, 0x43, 0x20, 0xf1, 0x02, 0x3c,
+	0x02, 0x37, 0x1e, 0xa2, 0xc7, 0x8a, 0x13, 0xe2, 0x50, 0xa1, 0xf6, 0xcc, 0x3b, 0xa0, 0x5d, 0x9b,
+	0xd4, 0x85, 0x4b, 0x50, 0x8f, 0x1c, 0x2c, 0x65, 0xbe, 0xf9, 0xbe, 0xd1, 0xcc, 0xb7, 0x93, 0x5d,
+	0xa8, 0x60, 0x7f, 0xa4, 0xf3, 0x6f, 0xd2, 0xd4, 0x69, 0xe8, 0x90, 0x70, 0x40, 0xe9, 0xb9, 0x36,
+	0x0a, 0x29, 0xa3, 0x08, 0xb0, 0x3f, 0xd2, 0xf8, 0x37, 0x69, 0x56, 0xd4, 0x14, 0x2f, 0xa4, 0x53,
+	0xec, 0xb1, 0x69, 0xcc, 0xaa, 0x54, 0x87, 0x34, 0xf2, 0x69, 0xa4, 0x0f, 0x70, 0x44, 0xf4, 0x49,
+	0x73, 0x40, 0x18, 0x6e, 0xea, 0x43, 0xea, 0x06, 0x49, 0x7e, 0x23, 0xce, 0xdb, 0x22, 0xd2, 0xe3,
+	0x20, 0x49, 0xad, 0x9c, 0xd1, 0x33, 0x1a, 0xe3, 0xfc, 0x57, 0x8c, 0xd6, 0x3f, 0x65, 0x21, 0x7f,
+	0xc8, 0x5b, 0x41, 0x25, 0x90, 0x5d, 0x47, 0x95, 0x6a, 0x52, 0x23, 0x67, 0xc9, 0xae, 0x83, 0x56,
+	0x20, 0x4f, 0xdf, 0x05, 0x24, 0x54, 0xe5, 0x9a, 0xd4, 0x50, 0xac, 0x38, 0x40, 0x5b, 0x90, 0x8b,
+	0x5c, 0x87, 0xa8, 0xd9, 0x9a, 0xd4, 0x28, 0xed, 0xac, 0x6a, 0xb7, 0x5d, 0x6b, 0xa2, 0x4c, 0xdf,
+	0x75, 0x88, 0x25, 0x28, 0x68, 0x13, 0x8a, 0x38, 0x8a, 0x08, 0xb3, 0x1d, 0x12, 0x50, 0x5f, 0xcd,
+	0x89, 0x32, 0x20, 0xa0, 0x0e, 0x47, 0xd0, 0x2e, 0xc0, 0x38, 0x70, 0x99, 0x3d, 0x0a, 0xdd, 0x21,
+	0x51, 0xf3, 0x35, 0xa9, 0x51, 0xdc, 0xd9, 0xd0, 0x92, 0xa6, 0xf9, 0x84, 0x5a, 0x32, 0xa1, 0xd6,
+	0xa6, 0x6e, 0xd0, 0xca, 0x5d, 0x5c, 0x6d, 0x66, 0x2c, 0x85, 0x4b, 0x7a, 0x5c, 0x81, 0x5e, 0xc1,
+	0xe2, 0xdb, 0x31, 0x0e, 0x98, 0xcb, 0xa6, 0x6a, 0x81, 0x57, 0x6f, 0x3d, 0xe5, 0x94, 0xef, 0x57,
+	0x9b, 0xab, 0x71, 0x91, 0xc8, 0x39, 0xd7, 0x5c, 0xaa, 0xfb, 0x98, 0xbd, 0xd1, 0xcc, 0x80, 0x7d,
+	0xfd, 0xf2, 0x0c, 0x92, 0xea, 0x66, 0xc0, 0xac, 0x99, 0x18, 0x99, 0xa0, 0x84, 0xc4, 0xc7, 0x6e,
+	0xe0, 0x06, 0x67, 0xea, 0xc2, 0xbf, 0x57, 0xba, 0x55, 0x23, 0x1d, 0x0a, 0x11, 0xc3, 0x6c, 0x1c,
+	0xa9, 0x8b, 0xc2, 0xa1, 0xf5, 0xbf, 0x1d, 0x12, 0x69, 0x2b, 0xa1, 0xa1, 0xc7, 0x00, 0xc3, 0x90,
+	0x60, 0x46, 0x1c, 0x1b, 0x33, 0x55, 0xa9, 0x49, 0x8d, 0xac, 0xa5, 0x24, 0xc8, 0x1e, 0xab, 0x7f,
+	0x96, 0x00, 0xc4, 0xb4, 0x5d, 0x32, 0x21, 0xde, 0x1f, 0x96, 0x49, 0xf7, 0xb2, 0x4c, 0xbe, 0x8f,
+	0x65, 0x6b, 0x50, 0x10, 0x1b, 0x1c, 0x89, 0x4d, 0x78, 0x68, 0x25, 0x51, 0xfd, 0x83, 0x0c, 0x65,
+	0x63, 0x42, 0x02, 0x26, 0x66, 0xed, 0x79, 0x78, 0x48, 0x9c, 0xff, 0x78, 0xb5, 0xea, 0x3f, 0xef,
+	0xf8, 0xb1, 0xef, 0x7a, 0x1e, 0x71, 0xd0, 0x13, 0x28, 0xf9, 0xf8, 0x9c, 0x84, 0xb6, 0x30, 0xcd,
+	0x9e, 0x79, 0xf3, 0x40, 0xa0, 0x82, 0x69, 0x0a, 0x16, 0xbb, 0xcb, 0x92, 0x63, 0x16, 0x4b, 0xb3,
+	0x56, 0x20, 0x3f, 0x18, 0x4f, 0x49, 0x28, 0x6c, 0x53, 0xac, 0x38, 0xe0, 0xc7, 0x13, 0x11, 0xcf,
+	0x23, 0x61, 0xe2, 0x4d, 0x12, 0xa1, 0x17, 0x90, 0x17, 0x2e, 0xcd, 0x6b, 0x49, 0xcc, 0xe6, 0xb2,
+	0xd8, 0xc9, 0xc2, 0x9c, 0x32, 0xc1, 0x46, 0x4d, 0xc8, 0x9e, 0x12, 0xa2, 0x2e, 0xcc, 0x27, 0xe2,
+	0x5c, 0xb4, 0x0b, 0x4a, 0x7c, 0xe3, 0xb9, 0x84, 0xff, 0x85, 0xb2, 0x8d, 0xe2, 0x4e, 0x25, 0xbd,
+	0x09, 0x56, 0x7c, 0x1d, 0xf6, 0xf0, 0xd4, 0x27, 0x01, 0xfb, 0x7d, 0x70, 0x33, 0x49, 0x9d, 0xc1,
+	0xf2, 0xad, 0xdd, 0x6d, 0x1c, 0x0c, 0x89, 0xe7, 0xcd, 0xbd, 0x81, 0x2f, 0xa1, 0x10, 0x92, 0xd3,
+	0x71, 0xe0, 0xa8, 0xd9, 0xf9, 0x5a, 0x4e, 0xe8, 0xdb, 0x3d, 0x50, 0x66, 0x2b, 0x8a, 0x2a, 0xb0,
+	0x76, 0x68, 0x75, 0x0c, 0xcb, 0xee, 0x9b, 0x1d, 0xc3, 0x3e, 0x3e, 0xe8, 0xf7, 0x8c, 0xb6, 0xb9,
+	0x6f, 0x1a, 0x9d, 0x72, 0x06, 0x21, 0x28, 0xa5, 0x72, 0xad, 0xe3, 0x93, 0xb2, 0x84, 0x96, 0x61,
+	0x29, 0x85, 0xf5, 0x8d, 0x6e, 0xb7, 0x2c, 0x6f, 0x9f, 0x40, 0x31, 0x75, 0x5b, 0xa0, 0x55, 0x78,
+	0x94, 0x70, 0x8e, 0xf6, 0x8e, 0x8e, 0xfb, 0xf6, 0x61, 0xcf, 0x38, 0x28, 0x67, 0xd0, 0x3a, 0x2c,
+	0xdf, 0x81, 0xf7, 0xcd, 0x6e, 0xd7, 0xe8, 0x94, 0xa5, 0x54, 0x0f, 0x71, 0xa2, 0xbd, 0x77, 0xd0,
+	0x36, 0x44, 0x4e, 0x6e, 0x6d, 0x5d, 0x5c, 0x57, 0xa5, 0xcb, 0xeb, 0xaa, 0xf4, 0xe3, 0xba, 0x2a,
+	0x7d, 0xbc, 0xa9, 0x66, 0x2e, 0x6f, 0xaa, 0x99, 0x6f, 0x37, 0xd5, 0xcc, 0xeb, 0x25, 0xfe, 0xe6,
+	0xbc, 0x17, 0x2f, 0x0f, 0x9b, 0x8e, 0x48, 0x34, 0x28, 0x88, 0x47, 0xe2, 0xf9, 0xaf, 0x01, 0x00,
+	0xa6, 0x1c, 0x15, 0xc6, 0xb9, 0x06, 0x00, 0x00,
+}
+
+func (m *Order) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Order) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Order) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintOrderbook(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Status != 0 {
+		i = encodeVarintOrderbook(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.Remaining.Size()
+		i -= size
+		if _, err := m.Remaining.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrderbook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrderbook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.UnitPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrderbook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintOrderbook(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Side != 0 {
+		i = encodeVarintOrderbook(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintOrderbook(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintOrderbook(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceLevel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceLevel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceLevel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Orders != 0 {
+		i = encodeVarintOrderbook(dAtA, i, uint64(m.Orders))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrderbook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.UnitPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrderbook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderPlaced) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderPlaced) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderPlaced) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOrderbook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.UnitPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrderbook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintOrderbook(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Side != 0 {
+		i = encodeVarintOrderbook(dAtA, i, uint64(m.Side))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintOrderbook(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintOrderbook(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderFilled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderFilled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderFilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Royalties) > 0 {
+		for iNdEx := len(m.Royalties) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Royalties[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOrderbook(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrderbook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrderbook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Asset.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrderbook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintOrderbook(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintOrderbook(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TakerOrderId != 0 {
+		i = encodeVarintOrderbook(dAtA, i, uint64(m.TakerOrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MakerOrderId != 0 {
+		i = encodeVarintOrderbook(dAtA, i, uint64(m.MakerOrderId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderCancelled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderCancelled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderCancelled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Refund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOrderbook(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintOrderbook(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintOrderbook(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOrderbook(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOrderbook(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Order) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovOrderbook(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovOrderbook(uint64(l))
+	}
+	if m.Side != 0 {
+		n += 1 + sovOrderbook(uint64(m.Side))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovOrderbook(uint64(l))
+	}
+	l = m.UnitPrice.Size()
+	n += 1 + l + sovOrderbook(uint64(l))
+	l = m.Quantity.Size()
+	n += 1 + l + sovOrderbook(uint64(l))
+	l = m.Remaining.Size()
+	n += 1 + l + sovOrderbook(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovOrderbook(uint64(m.Status))
+	}
+	if m.CreatedAt != 0 {
+		n += 1 + sovOrderbook(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func (m *PriceLevel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UnitPrice.Size()
+	n += 1 + l + sovOrderbook(uint64(l))
+	l = m.Quantity.Size()
+	n += 1 + l + sovOrderbook(uint64(l))
+	if m.Orders != 0 {
+		n += 1 + sovOrderbook(uint64(m.Orders))
+	}
+	return n
+}
+
+func (m *EventOrderPlaced) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovOrderbook(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovOrderbook(uint64(l))
+	}
+	if m.Side != 0 {
+		n += 1 + sovOrderbook(uint64(m.Side))
+	}
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovOrderbook(uint64(l))
+	}
+	l = m.UnitPrice.Size()
+	n += 1 + l + sovOrderbook(uint64(l))
+	l = m.Quantity.Size()
+	n += 1 + l + sovOrderbook(uint64(l))
+	return n
+}
+
+func (m *EventOrderFilled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MakerOrderId != 0 {
+		n += 1 + sovOrderbook(uint64(m.MakerOrderId))
+	}
+	if m.TakerOrderId != 0 {
+		n += 1 + sovOrderbook(uint64(m.TakerOrderId))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovOrderbook(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovOrderbook(uint64(l))
+	}
+	l = m.Asset.Size()
+	n += 1 + l + sovOrderbook(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovOrderbook(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovOrderbook(uint64(l))
+	if len(m.Royalties) > 0 {
+		for _, e := range m.Royalties {
+			l = e.Size()
+			n += 1 + l + sovOrderbook(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventOrderCancelled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovOrderbook(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovOrderbook(uint64(l))
+	}
+	l = m.Refund.Size()
+	n += 1 + l + sovOrderbook(uint64(l))
+	return n
+}
+
+func sovOrderbook(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOrderbook(x uint64) (n int) {
+	return sovOrderbook(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Order) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrderbook
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Order: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Order: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= OrderSide(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= OrderStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			m.CreatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrderbook(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceLevel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrderbook
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceLevel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceLevel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			m.Orders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Orders |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrderbook(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderPlaced) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrderbook
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderPlaced: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderPlaced: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Side", wireType)
+			}
+			m.Side = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Side |= OrderSide(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnitPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrderbook(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderFilled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrderbook
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderFilled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderFilled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerOrderId", wireType)
+			}
+			m.MakerOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MakerOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerOrderId", wireType)
+			}
+			m.TakerOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TakerOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Asset.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Royalties", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Royalties = append(m.Royalties, RoyaltyPayment{})
+			if err := m.Royalties[len(m.Royalties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrderbook(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderCancelled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOrderbook
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderCancelled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderCancelled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Refund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOrderbook(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOrderbook
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipOrderbook(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowOrderbook
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowOrderbook
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthOrderbook
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupOrderbook
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthOrderbook
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthOrderbook        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowOrderbook          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupOrderbook = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,10 +1,73 @@
 package types
 
-import sdkmath "cosmossdk.io/math"
+import (
+    errorsmod "cosmossdk.io/errors"
+    sdkmath "cosmossdk.io/math"
+    sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultMaxExpiredListingsPerBlock is the default cap on listings expired, and likewise on
+// offers expired, in one EndBlock.
+const DefaultMaxExpiredListingsPerBlock uint32 = 100
+
+// DefaultMaxSettledAuctionsPerBlock is the default cap on auctions settled in one EndBlock.
+const DefaultMaxSettledAuctionsPerBlock uint32 = 100
+
+// DefaultDeliveryTimeout is the default number of seconds a delivery escrow payment is held
+// before it is released to the seller without the buyer's confirmation.
+const DefaultDeliveryTimeout uint64 = 14 * 24 * 60 * 60
+
+// DefaultMaxReleasedDeliveriesPerBlock is the default cap on overdue delivery escrow payments
+// released in one EndBlock.
+const DefaultMaxReleasedDeliveriesPerBlock uint32 = 100
+
+// DefaultMaxOpenOffersPerListing is the default cap on offers open on one listing.
+const DefaultMaxOpenOffersPerListing uint32 = 50
+
+// DefaultDisputeTimeout is the default number of seconds arbiters have to resolve a dispute.
+const DefaultDisputeTimeout uint64 = 30 * 24 * 60 * 60
+
+// DefaultDisputeSellerBondRate is the default share of a disputed payment bonding the seller (10%).
+var DefaultDisputeSellerBondRate = sdkmath.LegacyNewDecWithPrec(1, 1)
+
+// DefaultMaxBatchSize is the default cap on entries in one batch message.
+const DefaultMaxBatchSize uint32 = 50
+
+// DefaultMaxRoyaltyRate is the default cap on creator royalty rates (10%).
+var DefaultMaxRoyaltyRate = sdkmath.LegacyNewDecWithPrec(1, 1)
+
+// DefaultStatsEpochIdentifier is the default x/epochs epoch market stats are snapshotted at.
+const DefaultStatsEpochIdentifier = "day"
+
+// DefaultMaxTWAPWindow is the default cap in seconds on time-weighted average price windows.
+const DefaultMaxTWAPWindow uint64 = 30 * 24 * 60 * 60
+
+// DefaultStatsSnapshotRetention is the default number of the latest market stats snapshots kept.
+const DefaultStatsSnapshotRetention uint32 = 365
 
 // NewParams creates a new Params instance.
 func NewParams() Params {
-    return Params{CommissionRate: ZeroDec()}
+    return Params{
+        CommissionRate:                ZeroDec(),
+        MaxExpiredListingsPerBlock:    DefaultMaxExpiredListingsPerBlock,
+        MaxOpenOffersPerListing:       DefaultMaxOpenOffersPerListing,
+        MaxSettledAuctionsPerBlock:    DefaultMaxSettledAuctionsPerBlock,
+        DeliveryTimeout:               DefaultDeliveryTimeout,
+        MaxReleasedDeliveriesPerBlock: DefaultMaxReleasedDeliveriesPerBlock,
+        DisputeTimeout:                DefaultDisputeTimeout,
+        DisputeSellerBondRate:         DefaultDisputeSellerBondRate,
+        MaxBatchSize:                  DefaultMaxBatchSize,
+        MaxRoyaltyRate:                DefaultMaxRoyaltyRate,
+        FeeSplit: FeeSplit{
+            CommunityPool: ZeroDec(),
+            Burn:          ZeroDec(),
+            Treasury:      ZeroDec(),
+        },
+        ReferralShare:          ZeroDec(),
+        StatsEpochIdentifier:   DefaultStatsEpochIdentifier,
+        MaxTwapWindow:          DefaultMaxTWAPWindow,
+        StatsSnapshotRetention: DefaultStatsSnapshotRetention,
+    }
 }
 
 // DefaultParams returns a default set of parameters.
@@ -14,16 +77,171 @@ func DefaultParams() Params {
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
-    // CommissionRate must be in [0,1]
-    if p.CommissionRate.IsNegative() {
-        return ErrInvalidCommissionRate
+    // CommissionRate must be in [0,1]; an unset rate means no commission
+    if !p.CommissionRate.IsNil() {
+        if p.CommissionRate.IsNegative() || p.CommissionRate.GT(OneDec()) {
+            return ErrInvalidCommissionRate
+        }
+    }
+    // MaxRoyaltyRate must be in [0,1]; an unset cap means no royalties
+    if !p.MaxRoyaltyRate.IsNil() {
+        if p.MaxRoyaltyRate.IsNegative() || p.MaxRoyaltyRate.GT(OneDec()) {
+            return ErrInvalidRoyalty
+        }
+    }
+    seen := make(map[string]bool, len(p.DenomCommissions))
+    for _, dc := range p.DenomCommissions {
+        if seen[dc.Denom] {
+            return errorsmod.Wrapf(ErrInvalidCommission, "duplicate denom %s", dc.Denom)
+        }
+        seen[dc.Denom] = true
+        if err := dc.Validate(); err != nil {
+            return err
+        }
+    }
+    if err := p.FeeSplit.Validate(); err != nil {
+        return err
+    }
+    // ReferralShare is paid to up to two referrers per sale, so it must be in [0,0.5]
+    if !p.ReferralShare.IsNil() {
+        if p.ReferralShare.IsNegative() || p.ReferralShare.GT(sdkmath.LegacyNewDecWithPrec(5, 1)) {
+            return errorsmod.Wrap(ErrInvalidReferrer, "referral share must be between 0 and 0.5")
+        }
+    }
+    // DisputeSellerBondRate must be in [0,1]; an unset rate means sellers are not bonded
+    if !p.DisputeSellerBondRate.IsNil() && !validRate(p.DisputeSellerBondRate) {
+        return errorsmod.Wrap(ErrInvalidSellerBond, "dispute seller bond rate must be between 0 and 1")
+    }
+    // an unset dispute deposit means disputes are free to open
+    if p.DisputeDeposit != nil {
+        if err := p.DisputeDeposit.Validate(); err != nil {
+            return err
+        }
+    }
+    // an unset listing deposit means listings are free to open
+    if p.ListingDeposit != nil {
+        if err := p.ListingDeposit.Validate(); err != nil {
+            return err
+        }
+    }
+    return nil
+}
+
+// Commission returns the commission on price: the DenomCommissions entry of its denom if
+// there is one, otherwise CommissionRate, rounded up so small sales are not fee-free. It
+// never exceeds price.
+func (p Params) Commission(price sdk.Coin) sdkmath.Int {
+    rate := p.CommissionRate
+    minFee, maxFee := sdkmath.ZeroInt(), sdkmath.ZeroInt()
+    for _, dc := range p.DenomCommissions {
+        if dc.Denom != price.Denom {
+            continue
+        }
+        rate = dc.RateFor(price.Amount)
+        if !dc.MinFee.IsNil() {
+            minFee = dc.MinFee
+        }
+        if !dc.MaxFee.IsNil() {
+            maxFee = dc.MaxFee
+        }
+        break
     }
-    if p.CommissionRate.GT(OneDec()) {
-        return ErrInvalidCommissionRate
+    if rate.IsNil() {
+        rate = ZeroDec()
+    }
+
+    fee := sdkmath.LegacyNewDecFromInt(price.Amount).Mul(rate).Ceil().TruncateInt()
+    fee = sdkmath.MaxInt(fee, minFee)
+    if maxFee.IsPositive() {
+        fee = sdkmath.MinInt(fee, maxFee)
+    }
+    return sdkmath.MinInt(fee, price.Amount)
+}
+
+// Validate checks a denom's commission schedule: rates in [0,1], non-negative fee bounds
+// with min_fee at most a set max_fee, and strictly ascending positive tier thresholds.
+func (dc DenomCommission) Validate() error {
+    if err := sdk.ValidateDenom(dc.Denom); err != nil {
+        return errorsmod.Wrap(ErrInvalidCommission, err.Error())
+    }
+    if !validRate(dc.Rate) {
+        return errorsmod.Wrapf(ErrInvalidCommission, "%s rate must be between 0 and 1", dc.Denom)
+    }
+    minFee, maxFee := dc.MinFee, dc.MaxFee
+    if minFee.IsNil() {
+        minFee = sdkmath.ZeroInt()
+    }
+    if maxFee.IsNil() {
+        maxFee = sdkmath.ZeroInt()
+    }
+    if minFee.IsNegative() || maxFee.IsNegative() {
+        return errorsmod.Wrapf(ErrInvalidCommission, "%s fee bounds must not be negative", dc.Denom)
+    }
+    if maxFee.IsPositive() && minFee.GT(maxFee) {
+        return errorsmod.Wrapf(ErrInvalidCommission, "%s min fee exceeds max fee", dc.Denom)
+    }
+    last := sdkmath.ZeroInt()
+    for _, tier := range dc.Tiers {
+        if tier.Threshold.IsNil() || !tier.Threshold.GT(last) {
+            return errorsmod.Wrapf(ErrInvalidCommission, "%s tier thresholds must be positive and ascending", dc.Denom)
+        }
+        if !validRate(tier.Rate) {
+            return errorsmod.Wrapf(ErrInvalidCommission, "%s tier rate must be between 0 and 1", dc.Denom)
+        }
+        last = tier.Threshold
+    }
+    return nil
+}
+
+// RateFor returns the rate of the highest tier amount reaches, or Rate below the first.
+func (dc DenomCommission) RateFor(amount sdkmath.Int) sdkmath.LegacyDec {
+    rate := dc.Rate
+    for _, tier := range dc.Tiers {
+        if amount.LT(tier.Threshold) {
+            break
+        }
+        rate = tier.Rate
+    }
+    return rate
+}
+
+// Validate checks that every share is in [0,1] and that they sum to at most 1. Unset
+// shares count as zero.
+func (s FeeSplit) Validate() error {
+    total := ZeroDec()
+    for _, share := range []sdkmath.LegacyDec{s.CommunityPool, s.Burn, s.Treasury} {
+        if share.IsNil() {
+            continue
+        }
+        if !validRate(share) {
+            return errorsmod.Wrap(ErrInvalidFeeSplit, "shares must be between 0 and 1")
+        }
+        total = total.Add(share)
+    }
+    if total.GT(OneDec()) {
+        return errorsmod.Wrapf(ErrInvalidFeeSplit, "shares sum to %s, more than 1", total)
     }
     return nil
 }
 
+// Split divides fee into the community pool, burn and treasury shares, each rounded down,
+// and the rest for the fee collector.
+func (s FeeSplit) Split(fee sdk.Coin) (communityPool, burn, treasury, feeCollector sdk.Coin) {
+    share := func(rate sdkmath.LegacyDec) sdk.Coin {
+        if rate.IsNil() {
+            return sdk.NewCoin(fee.Denom, sdkmath.ZeroInt())
+        }
+        return sdk.NewCoin(fee.Denom, sdkmath.LegacyNewDecFromInt(fee.Amount).Mul(rate).TruncateInt())
+    }
+    communityPool, burn, treasury = share(s.CommunityPool), share(s.Burn), share(s.Treasury)
+    feeCollector = fee.Sub(communityPool).Sub(burn).Sub(treasury)
+    return communityPool, burn, treasury, feeCollector
+}
+
+func validRate(rate sdkmath.LegacyDec) bool {
+    return !rate.IsNil() && !rate.IsNegative() && rate.LTE(OneDec())
+}
+
 // helpers for decimals without importing sdk.Dec directly in generated types
 func ZeroDec() sdkmath.LegacyDec { return sdkmath.LegacyNewDec(0) }
 func OneDec() sdkmath.LegacyDec  { return sdkmath.LegacyNewDec(1) }
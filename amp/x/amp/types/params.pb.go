@@ -6,6 +6,8 @@ package types
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -27,8 +29,56 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
-	// commission_rate is a decimal in [0,1] applied on price
+	// commission_rate is a decimal in [0,1] applied on price in denoms without a denom_commissions entry
 	CommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=commission_rate,json=commissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission_rate"`
+	// max_expired_listings_per_block caps how many expired listings and expired offers EndBlock
+	// processes each; 0 disables their processing
+	MaxExpiredListingsPerBlock uint32 `protobuf:"varint,2,opt,name=max_expired_listings_per_block,json=maxExpiredListingsPerBlock,proto3" json:"max_expired_listings_per_block,omitempty"`
+	// delivery_timeout is how many seconds after a delivery escrow sale its payment is released if the buyer does not confirm; 0 releases it in the next EndBlock
+	DeliveryTimeout uint64 `protobuf:"varint,3,opt,name=delivery_timeout,json=deliveryTimeout,proto3" json:"delivery_timeout,omitempty"`
+	// dispute_deposit is what a buyer locks to open a dispute and forfeits to the seller on losing it;
+	// unset for none. The seller's side is bonded by dispute_seller_bond_rate
+	DisputeDeposit *types.Coin `protobuf:"bytes,4,opt,name=dispute_deposit,json=disputeDeposit,proto3" json:"dispute_deposit,omitempty"`
+	// max_batch_size caps how many entries one MsgBatchBuy or MsgBatchList may carry; 0 disables batch messages
+	MaxBatchSize uint32 `protobuf:"varint,5,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+	// max_royalty_rate caps the royalty rate creators may register, and the rate paid on sales, in [0,1]
+	MaxRoyaltyRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_royalty_rate,json=maxRoyaltyRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_royalty_rate"`
+	// denom_commissions replace commission_rate for prices paid in their denom
+	DenomCommissions []DenomCommission `protobuf:"bytes,7,rep,name=denom_commissions,json=denomCommissions,proto3" json:"denom_commissions"`
+	// fee_split routes shares of every commission away from the fee collector
+	FeeSplit FeeSplit `protobuf:"bytes,8,opt,name=fee_split,json=feeSplit,proto3" json:"fee_split"`
+	// referral_share is the share of a sale's commission paid to each of the seller's and the
+	// buyer's referrer, in [0,0.5]; fee_split applies to what is left
+	ReferralShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=referral_share,json=referralShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"referral_share"`
+	// listing_deposit is what a seller locks per listing, refunded on sale or delist and forfeited to the community pool on expiry or takedown; unset for none
+	ListingDeposit *types.Coin `protobuf:"bytes,10,opt,name=listing_deposit,json=listingDeposit,proto3" json:"listing_deposit,omitempty"`
+	// max_active_listings_per_seller caps how many open listings one seller may have; 0 for no cap
+	MaxActiveListingsPerSeller uint32 `protobuf:"varint,11,opt,name=max_active_listings_per_seller,json=maxActiveListingsPerSeller,proto3" json:"max_active_listings_per_seller,omitempty"`
+	// stats_epoch_identifier is the x/epochs epoch at whose end the market statistics are
+	// snapshotted, e.g. "day"; empty disables snapshots
+	StatsEpochIdentifier string `protobuf:"bytes,12,opt,name=stats_epoch_identifier,json=statsEpochIdentifier,proto3" json:"stats_epoch_identifier,omitempty"`
+	// max_twap_window caps the window in seconds of time-weighted average prices; price history
+	// older than it is pruned. 0 for no cap, which keeps all history
+	MaxTwapWindow uint64 `protobuf:"varint,13,opt,name=max_twap_window,json=maxTwapWindow,proto3" json:"max_twap_window,omitempty"`
+	// max_released_deliveries_per_block caps how many overdue delivery escrow payments EndBlock
+	// releases, and likewise how many timed out disputes it resolves; 0 disables both
+	MaxReleasedDeliveriesPerBlock uint32 `protobuf:"varint,14,opt,name=max_released_deliveries_per_block,json=maxReleasedDeliveriesPerBlock,proto3" json:"max_released_deliveries_per_block,omitempty"`
+	// max_open_offers_per_listing caps how many offers may be open on one listing at a time, which
+	// bounds the refunds when the listing closes; 0 for no cap
+	MaxOpenOffersPerListing uint32 `protobuf:"varint,15,opt,name=max_open_offers_per_listing,json=maxOpenOffersPerListing,proto3" json:"max_open_offers_per_listing,omitempty"`
+	// dispute_timeout is how many seconds an arbiter has to resolve a dispute before EndBlock
+	// splits the payment evenly and returns both bonds; 0 for no timeout
+	DisputeTimeout uint64 `protobuf:"varint,16,opt,name=dispute_timeout,json=disputeTimeout,proto3" json:"dispute_timeout,omitempty"`
+	// dispute_seller_bond_rate is the share of the escrowed payment held as the seller's bond when
+	// a dispute opens, in [0,1]; it is paid to the buyer out of the seller's share if the buyer is
+	// awarded more than half
+	DisputeSellerBondRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,17,opt,name=dispute_seller_bond_rate,json=disputeSellerBondRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"dispute_seller_bond_rate"`
+	// max_settled_auctions_per_block caps how many ended auctions EndBlock settles; 0 disables
+	// their settlement
+	MaxSettledAuctionsPerBlock uint32 `protobuf:"varint,18,opt,name=max_settled_auctions_per_block,json=maxSettledAuctionsPerBlock,proto3" json:"max_settled_auctions_per_block,omitempty"`
+	// stats_snapshot_retention is how many of the latest market stats snapshots are kept; older
+	// ones are pruned. 0 keeps all snapshots
+	StatsSnapshotRetention uint32 `protobuf:"varint,19,opt,name=stats_snapshot_retention,json=statsSnapshotRetention,proto3" json:"stats_snapshot_retention,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -64,28 +114,384 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMaxExpiredListingsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxExpiredListingsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetDeliveryTimeout() uint64 {
+	if m != nil {
+		return m.DeliveryTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetDisputeDeposit() *types.Coin {
+	if m != nil {
+		return m.DisputeDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetMaxBatchSize() uint32 {
+	if m != nil {
+		return m.MaxBatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetDenomCommissions() []DenomCommission {
+	if m != nil {
+		return m.DenomCommissions
+	}
+	return nil
+}
+
+func (m *Params) GetFeeSplit() FeeSplit {
+	if m != nil {
+		return m.FeeSplit
+	}
+	return FeeSplit{}
+}
+
+func (m *Params) GetListingDeposit() *types.Coin {
+	if m != nil {
+		return m.ListingDeposit
+	}
+	return nil
+}
+
+func (m *Params) GetMaxActiveListingsPerSeller() uint32 {
+	if m != nil {
+		return m.MaxActiveListingsPerSeller
+	}
+	return 0
+}
+
+func (m *Params) GetStatsEpochIdentifier() string {
+	if m != nil {
+		return m.StatsEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetMaxTwapWindow() uint64 {
+	if m != nil {
+		return m.MaxTwapWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMaxReleasedDeliveriesPerBlock() uint32 {
+	if m != nil {
+		return m.MaxReleasedDeliveriesPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxOpenOffersPerListing() uint32 {
+	if m != nil {
+		return m.MaxOpenOffersPerListing
+	}
+	return 0
+}
+
+func (m *Params) GetDisputeTimeout() uint64 {
+	if m != nil {
+		return m.DisputeTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSettledAuctionsPerBlock() uint32 {
+	if m != nil {
+		return m.MaxSettledAuctionsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetStatsSnapshotRetention() uint32 {
+	if m != nil {
+		return m.StatsSnapshotRetention
+	}
+	return 0
+}
+
+// FeeSplit divides each commission. Every share is a decimal in [0,1], the shares sum to at
+// most 1, and the fee collector receives the rest, including rounding remainders.
+type FeeSplit struct {
+	// community_pool is the share funded to the x/distribution community pool
+	CommunityPool cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=community_pool,json=communityPool,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"community_pool"`
+	// burn is the share burned from the supply
+	Burn cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=burn,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"burn"`
+	// treasury is the share kept in the marketplace treasury, spendable only by the module authority
+	Treasury cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=treasury,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"treasury"`
+}
+
+func (m *FeeSplit) Reset()         { *m = FeeSplit{} }
+func (m *FeeSplit) String() string { return proto.CompactTextString(m) }
+func (*FeeSplit) ProtoMessage()    {}
+func (*FeeSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b678013c320e05e, []int{1}
+}
+func (m *FeeSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSplit.Merge(m, src)
+}
+func (m *FeeSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSplit proto.InternalMessageInfo
+
+// Event emitted when the module authority spends from the marketplace treasury
+type EventTreasurySpent struct {
+	Recipient string                                   `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *EventTreasurySpent) Reset()         { *m = EventTreasurySpent{} }
+func (m *EventTreasurySpent) String() string { return proto.CompactTextString(m) }
+func (*EventTreasurySpent) ProtoMessage()    {}
+func (*EventTreasurySpent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b678013c320e05e, []int{2}
+}
+func (m *EventTreasurySpent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTreasurySpent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTreasurySpent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTreasurySpent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTreasurySpent.Merge(m, src)
+}
+func (m *EventTreasurySpent) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTreasurySpent) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTreasurySpent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTreasurySpent proto.InternalMessageInfo
+
+func (m *EventTreasurySpent) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *EventTreasurySpent) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// DenomCommission is the commission schedule of one price denom. The fee is the price
+// times the rate of the highest tier the price reaches (or rate below the first tier),
+// rounded up, then raised to min_fee and lowered to max_fee.
+type DenomCommission struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate is a decimal in [0,1] applied on prices below the first tier
+	Rate   cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+	MinFee cosmossdk_io_math.Int       `protobuf:"bytes,3,opt,name=min_fee,json=minFee,proto3,customtype=cosmossdk.io/math.Int" json:"min_fee"`
+	// max_fee caps the fee; zero for no cap
+	MaxFee cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_fee,json=maxFee,proto3,customtype=cosmossdk.io/math.Int" json:"max_fee"`
+	// tiers must have strictly ascending thresholds
+	Tiers []CommissionTier `protobuf:"bytes,5,rep,name=tiers,proto3" json:"tiers"`
+}
+
+func (m *DenomCommission) Reset()         { *m = DenomCommission{} }
+func (m *DenomCommission) String() string { return proto.CompactTextString(m) }
+func (*DenomCommission) ProtoMessage()    {}
+func (*DenomCommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b678013c320e05e, []int{3}
+}
+func (m *DenomCommission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomCommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomCommission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomCommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomCommission.Merge(m, src)
+}
+func (m *DenomCommission) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomCommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomCommission.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomCommission proto.InternalMessageInfo
+
+func (m *DenomCommission) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomCommission) GetTiers() []CommissionTier {
+	if m != nil {
+		return m.Tiers
+	}
+	return nil
+}
+
+// CommissionTier applies rate to prices at or above threshold.
+type CommissionTier struct {
+	Threshold cosmossdk_io_math.Int       `protobuf:"bytes,1,opt,name=threshold,proto3,customtype=cosmossdk.io/math.Int" json:"threshold"`
+	Rate      cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *CommissionTier) Reset()         { *m = CommissionTier{} }
+func (m *CommissionTier) String() string { return proto.CompactTextString(m) }
+func (*CommissionTier) ProtoMessage()    {}
+func (*CommissionTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b678013c320e05e, []int{4}
+}
+func (m *CommissionTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommissionTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommissionTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommissionTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommissionTier.Merge(m, src)
+}
+func (m *CommissionTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommissionTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommissionTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommissionTier proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Params)(nil), "amp.amp.v1.Params")
+	proto.RegisterType((*FeeSplit)(nil), "amp.amp.v1.FeeSplit")
+	proto.RegisterType((*EventTreasurySpent)(nil), "amp.amp.v1.EventTreasurySpent")
+	proto.RegisterType((*DenomCommission)(nil), "amp.amp.v1.DenomCommission")
+	proto.RegisterType((*CommissionTier)(nil), "amp.amp.v1.CommissionTier")
 }
 
 func init() { proto.RegisterFile("amp/amp/v1/params.proto", fileDescriptor_5b678013c320e05e) }
 
 var fileDescriptor_5b678013c320e05e = []byte{
-	// 218 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4f, 0xcc, 0x2d, 0xd0,
-	0x07, 0xe1, 0x32, 0x43, 0xfd, 0x82, 0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0xbd, 0x82, 0xa2, 0xfc, 0x92,
-	0x7c, 0x21, 0xae, 0xc4, 0xdc, 0x02, 0x3d, 0x10, 0x2e, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc,
-	0xcb, 0xd7, 0x07, 0x93, 0x10, 0x69, 0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0x30, 0x53, 0x1f, 0xc4,
-	0x82, 0x88, 0x2a, 0x15, 0x72, 0xb1, 0x05, 0x80, 0x0d, 0x11, 0xf2, 0xe1, 0xe2, 0x4f, 0xce, 0xcf,
-	0xcd, 0xcd, 0x2c, 0x2e, 0xce, 0xcc, 0xcf, 0x8b, 0x2f, 0x4a, 0x2c, 0x49, 0x95, 0x60, 0x54, 0x60,
-	0xd4, 0xe0, 0x74, 0x52, 0x3e, 0x71, 0x4f, 0x9e, 0xe1, 0xd6, 0x3d, 0x79, 0xe9, 0xe4, 0xfc, 0xe2,
-	0xdc, 0xfc, 0xe2, 0xe2, 0x94, 0x6c, 0xbd, 0xcc, 0x7c, 0xfd, 0xdc, 0xc4, 0x92, 0x0c, 0x3d, 0x9f,
-	0xd4, 0xf4, 0xc4, 0xe4, 0x4a, 0x97, 0xd4, 0xe4, 0x20, 0x3e, 0x84, 0xde, 0xa0, 0xc4, 0x92, 0x54,
-	0x2b, 0xc9, 0x17, 0x0b, 0xe4, 0x19, 0xbb, 0x9e, 0x6f, 0xd0, 0x12, 0x00, 0x39, 0xb5, 0x02, 0xec,
-	0x60, 0x88, 0x45, 0x4e, 0x9a, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91,
-	0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xc5,
-	0x8f, 0x50, 0x5b, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x76, 0xa4, 0x31, 0x20, 0x00, 0x00,
-	0xff, 0xff, 0x2f, 0x5d, 0xf8, 0x17, 0xf4, 0x00, 0x00, 0x00,
+	// 1012 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x26, 0x4e, 0x1a, 0x4f, 0x1a, 0x3b, 0x19, 0x52, 0xba, 0x4d, 0xa8, 0x63, 0x02, 0x02,
+	0x17, 0x09, 0x9b, 0x14, 0x44, 0x51, 0x41, 0x42, 0x75, 0x93, 0x88, 0xa2, 0x40, 0xa3, 0x75, 0x24,
+	0x24, 0x84, 0x34, 0x1a, 0xef, 0x3e, 0xc7, 0xa3, 0xec, 0xce, 0xac, 0x66, 0xc6, 0x8e, 0xdd, 0x3f,
+	0x01, 0x21, 0xc4, 0x8d, 0x2b, 0x67, 0x4e, 0xfc, 0x19, 0x3d, 0xf6, 0x84, 0x10, 0x87, 0x02, 0xc9,
+	0x01, 0xfe, 0x0c, 0x34, 0x3f, 0xfc, 0x23, 0x20, 0x81, 0xd3, 0x83, 0x13, 0xef, 0x7b, 0xdf, 0xf7,
+	0xf6, 0xcd, 0xf7, 0xbe, 0x79, 0x09, 0xba, 0x49, 0xb3, 0xbc, 0x61, 0x3e, 0xfd, 0xdd, 0x46, 0x4e,
+	0x25, 0xcd, 0x54, 0x3d, 0x97, 0x42, 0x0b, 0x8c, 0x68, 0x96, 0xd7, 0xcd, 0xa7, 0xbf, 0xbb, 0xb9,
+	0x4e, 0x33, 0xc6, 0x45, 0xc3, 0xfe, 0x74, 0xe9, 0xcd, 0x4a, 0x2c, 0x54, 0x26, 0x54, 0xa3, 0x4d,
+	0x15, 0x34, 0xfa, 0xbb, 0x6d, 0xd0, 0x74, 0xb7, 0x11, 0x0b, 0xc6, 0x7d, 0x7e, 0xe3, 0x44, 0x9c,
+	0x08, 0xfb, 0xb5, 0x61, 0xbe, 0xb9, 0xe8, 0xce, 0x1f, 0x45, 0xb4, 0x74, 0x64, 0xdf, 0x82, 0x0f,
+	0x51, 0x39, 0x16, 0x59, 0xc6, 0x94, 0x62, 0x82, 0x13, 0x49, 0x35, 0x84, 0x41, 0x35, 0xa8, 0x15,
+	0x9b, 0xaf, 0x3d, 0x7d, 0xbe, 0x3d, 0xf7, 0xeb, 0xf3, 0xed, 0x2d, 0xf7, 0x06, 0x95, 0x9c, 0xd6,
+	0x99, 0x68, 0x64, 0x54, 0x77, 0xeb, 0x87, 0x70, 0x42, 0xe3, 0xe1, 0x1e, 0xc4, 0x51, 0x69, 0xc2,
+	0x8d, 0xa8, 0x06, 0xdc, 0x44, 0x95, 0x8c, 0x0e, 0x08, 0x0c, 0x72, 0x26, 0x21, 0x21, 0x29, 0x53,
+	0x9a, 0xf1, 0x13, 0x45, 0x72, 0x90, 0xa4, 0x9d, 0x8a, 0xf8, 0x34, 0x9c, 0xaf, 0x06, 0xb5, 0xd5,
+	0x68, 0x33, 0xa3, 0x83, 0x7d, 0x07, 0x3a, 0xf4, 0x98, 0x23, 0x90, 0x4d, 0x83, 0xc0, 0x77, 0xd0,
+	0x5a, 0x02, 0x29, 0xeb, 0x83, 0x1c, 0x12, 0xcd, 0x32, 0x10, 0x3d, 0x1d, 0x2e, 0x54, 0x83, 0x5a,
+	0x21, 0x2a, 0x8f, 0xe2, 0xc7, 0x2e, 0x8c, 0x9b, 0xa8, 0x9c, 0x30, 0x95, 0xf7, 0x34, 0x90, 0x04,
+	0x72, 0xa1, 0x98, 0x0e, 0x0b, 0xd5, 0xa0, 0xb6, 0x72, 0xf7, 0x56, 0xdd, 0x75, 0x5d, 0x37, 0xba,
+	0xd4, 0xbd, 0x2e, 0xf5, 0x87, 0x82, 0xf1, 0xa8, 0xe4, 0x19, 0x7b, 0x8e, 0x80, 0x5f, 0x47, 0x25,
+	0xd3, 0x72, 0x9b, 0xea, 0xb8, 0x4b, 0x14, 0x7b, 0x02, 0xe1, 0xa2, 0x6d, 0xf1, 0x7a, 0x46, 0x07,
+	0x4d, 0x13, 0x6c, 0xb1, 0x27, 0x80, 0x3f, 0x43, 0x6b, 0x06, 0x25, 0xc5, 0x90, 0xa6, 0x7a, 0xe8,
+	0x74, 0x5a, 0xba, 0x82, 0x4e, 0x19, 0x1d, 0x44, 0x8e, 0x6b, 0x75, 0xfa, 0x1c, 0xad, 0x27, 0xc0,
+	0x45, 0x46, 0x26, 0xfa, 0xa9, 0xf0, 0x5a, 0x75, 0xa1, 0xb6, 0x72, 0x77, 0xab, 0x3e, 0x99, 0x78,
+	0x7d, 0xcf, 0x80, 0x1e, 0x8e, 0x31, 0xcd, 0x82, 0x79, 0x59, 0xb4, 0x96, 0x5c, 0x0e, 0x2b, 0x7c,
+	0x0f, 0x15, 0x3b, 0x00, 0x44, 0xe5, 0x29, 0xd3, 0xe1, 0xb2, 0x95, 0x60, 0x63, 0xba, 0xce, 0x01,
+	0x40, 0xcb, 0xe4, 0x7c, 0x81, 0xe5, 0x8e, 0x7f, 0xc6, 0x9f, 0xa2, 0x92, 0x84, 0x0e, 0x48, 0x49,
+	0x53, 0xa2, 0xba, 0x54, 0x42, 0x58, 0x9c, 0xfd, 0x54, 0xab, 0x23, 0x6a, 0xcb, 0x30, 0xcd, 0x34,
+	0xfc, 0xc0, 0xc7, 0xd3, 0x40, 0xff, 0x3b, 0x0d, 0xcf, 0x18, 0x4d, 0xc3, 0x1b, 0x88, 0xc6, 0x9a,
+	0xf5, 0xe1, 0xb2, 0x7f, 0x14, 0xa4, 0x29, 0xc8, 0x70, 0x65, 0x6c, 0xa0, 0x07, 0x16, 0x34, 0xe5,
+	0x9f, 0x96, 0x45, 0xe0, 0xf7, 0xd0, 0xcb, 0x4a, 0x53, 0xad, 0x08, 0xe4, 0x22, 0xee, 0x12, 0x96,
+	0x00, 0xd7, 0xac, 0xc3, 0x40, 0x86, 0xd7, 0xcd, 0xd9, 0xa2, 0x0d, 0x9b, 0xdd, 0x37, 0xc9, 0x47,
+	0xe3, 0x1c, 0x7e, 0x03, 0x95, 0xcd, 0x9b, 0xf5, 0x19, 0xcd, 0xc9, 0x19, 0xe3, 0x89, 0x38, 0x0b,
+	0x57, 0xad, 0xeb, 0x56, 0x33, 0x3a, 0x38, 0x3e, 0xa3, 0xf9, 0x17, 0x36, 0x88, 0x3f, 0x41, 0xaf,
+	0x5a, 0x27, 0x40, 0x0a, 0x54, 0x41, 0x42, 0xbc, 0x27, 0x19, 0x4c, 0xbb, 0xbc, 0x64, 0x9b, 0xbc,
+	0x6d, 0xa6, 0xee, 0x71, 0x7b, 0x63, 0xd8, 0xd8, 0xe8, 0x1f, 0xa1, 0x2d, 0x53, 0x49, 0xe4, 0xc0,
+	0x89, 0xe8, 0x74, 0x40, 0xba, 0x0a, 0xfe, 0xd0, 0x61, 0xd9, 0xd6, 0xb8, 0x99, 0xd1, 0xc1, 0xe3,
+	0x1c, 0xf8, 0x63, 0x0b, 0x38, 0x02, 0xe9, 0xcf, 0x8b, 0xdf, 0x9c, 0x78, 0x7f, 0x74, 0x4b, 0xd6,
+	0x6c, 0xbf, 0x23, 0x83, 0x8f, 0x2e, 0xc9, 0x57, 0x28, 0x1c, 0x01, 0x9d, 0x84, 0xa4, 0x2d, 0x78,
+	0xe2, 0x2c, 0xbc, 0x3e, 0xfb, 0xb0, 0x6f, 0xf8, 0x22, 0x4e, 0xe4, 0xa6, 0xe0, 0xc9, 0xf4, 0x8d,
+	0x57, 0xa0, 0x75, 0x0a, 0x09, 0xa1, 0xbd, 0x58, 0x1b, 0x47, 0x4e, 0x69, 0x81, 0xc7, 0x03, 0x6b,
+	0x39, 0xd0, 0x03, 0x8f, 0x19, 0x0b, 0xf1, 0x01, 0x0a, 0xdd, 0xc0, 0x14, 0xa7, 0xb9, 0xea, 0x0a,
+	0x4d, 0x24, 0x68, 0x33, 0x18, 0xc1, 0xc3, 0x97, 0x2c, 0xdb, 0x0d, 0xb4, 0xe5, 0xd3, 0xd1, 0x28,
+	0x7b, 0xff, 0xd6, 0x5f, 0x3f, 0x6c, 0x07, 0x5f, 0xff, 0xf9, 0xd3, 0x5b, 0x6b, 0x66, 0x77, 0x0e,
+	0xec, 0x06, 0x75, 0x8b, 0x6d, 0xe7, 0xe7, 0x00, 0x2d, 0x1f, 0x4c, 0xd9, 0xdc, 0xdc, 0xb4, 0x1e,
+	0x67, 0x7a, 0x48, 0x72, 0x21, 0xd2, 0xab, 0x2c, 0xb9, 0xd5, 0x31, 0xf5, 0x48, 0x88, 0x14, 0xdf,
+	0x43, 0x85, 0x76, 0x4f, 0xf2, 0x70, 0x7e, 0xf6, 0x0a, 0x96, 0x80, 0x3f, 0x46, 0xcb, 0x5a, 0x02,
+	0x55, 0x3d, 0x39, 0x0c, 0x17, 0x66, 0x27, 0x8f, 0x49, 0xf7, 0x0b, 0xe6, 0xb4, 0x3b, 0xdf, 0x07,
+	0x08, 0xef, 0xf7, 0x81, 0xeb, 0x63, 0x1f, 0x6f, 0xe5, 0xc0, 0x35, 0x7e, 0x05, 0x15, 0x25, 0xc4,
+	0x2c, 0x67, 0xc0, 0xb5, 0x3b, 0x5d, 0x34, 0x09, 0xe0, 0x18, 0x2d, 0xd1, 0x4c, 0xf4, 0xb8, 0x0e,
+	0xe7, 0xab, 0x0b, 0xff, 0x79, 0x25, 0x9b, 0xef, 0x98, 0xa6, 0x7e, 0xfc, 0x6d, 0xbb, 0x76, 0xc2,
+	0x74, 0xb7, 0xd7, 0xae, 0xc7, 0x22, 0x6b, 0xf8, 0xbf, 0x32, 0xee, 0xd7, 0xdb, 0x2a, 0x39, 0x6d,
+	0xe8, 0x61, 0x0e, 0xca, 0x12, 0x54, 0xe4, 0x4b, 0xef, 0x7c, 0x3b, 0x8f, 0xca, 0xff, 0xd8, 0x58,
+	0x78, 0x03, 0x2d, 0xda, 0x6d, 0xe5, 0x5b, 0x72, 0x0f, 0x46, 0x43, 0xeb, 0xbf, 0xab, 0x68, 0x68,
+	0x08, 0xf8, 0x7d, 0x74, 0x2d, 0x63, 0x9c, 0x74, 0x00, 0xbc, 0x84, 0xb7, 0x3d, 0xf7, 0xc6, 0xbf,
+	0xb9, 0x8f, 0xb8, 0x8e, 0x96, 0x32, 0xc6, 0x0f, 0xc0, 0xf1, 0xe8, 0xc0, 0xf2, 0x0a, 0xb3, 0xf1,
+	0xe8, 0xc0, 0xf1, 0x16, 0x35, 0x03, 0xa9, 0xc2, 0x45, 0x2b, 0xdb, 0xe6, 0xf4, 0x52, 0x9d, 0x9c,
+	0xf2, 0x98, 0x81, 0xf4, 0xab, 0xd5, 0xc1, 0xfd, 0xa8, 0xbe, 0x09, 0x50, 0xe9, 0x32, 0x0a, 0x7f,
+	0x88, 0x8a, 0xba, 0x2b, 0x41, 0x75, 0x45, 0x9a, 0x84, 0xc1, 0x2c, 0xad, 0x4c, 0xf0, 0x2f, 0x2c,
+	0x9b, 0x6b, 0xa7, 0x79, 0xe7, 0xe9, 0x79, 0x25, 0x78, 0x76, 0x5e, 0x09, 0x7e, 0x3f, 0xaf, 0x04,
+	0xdf, 0x5d, 0x54, 0xe6, 0x9e, 0x5d, 0x54, 0xe6, 0x7e, 0xb9, 0xa8, 0xcc, 0x7d, 0x59, 0x9e, 0x5c,
+	0x1f, 0x3b, 0xd8, 0xf6, 0x92, 0xfd, 0x47, 0xe1, 0xdd, 0xbf, 0x07, 0x00, 0x3e, 0x4b, 0x57, 0x2f,
+	0x98, 0x08, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -110,70 +516,1745 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.CommissionRate.Equal(that1.CommissionRate) {
 		return false
 	}
+	if this.MaxExpiredListingsPerBlock != that1.MaxExpiredListingsPerBlock {
+		return false
+	}
+	if this.DeliveryTimeout != that1.DeliveryTimeout {
+		return false
+	}
+	if !this.DisputeDeposit.Equal(that1.DisputeDeposit) {
+		return false
+	}
+	if this.MaxBatchSize != that1.MaxBatchSize {
+		return false
+	}
+	if !this.MaxRoyaltyRate.Equal(that1.MaxRoyaltyRate) {
+		return false
+	}
+	if len(this.DenomCommissions) != len(that1.DenomCommissions) {
+		return false
+	}
+	for i := range this.DenomCommissions {
+		if !this.DenomCommissions[i].Equal(&that1.DenomCommissions[i]) {
+			return false
+		}
+	}
+	if !this.FeeSplit.Equal(&that1.FeeSplit) {
+		return false
+	}
+	if !this.ReferralShare.Equal(that1.ReferralShare) {
+		return false
+	}
+	if !this.ListingDeposit.Equal(that1.ListingDeposit) {
+		return false
+	}
+	if this.MaxActiveListingsPerSeller != that1.MaxActiveListingsPerSeller {
+		return false
+	}
+	if this.StatsEpochIdentifier != that1.StatsEpochIdentifier {
+		return false
+	}
+	if this.MaxTwapWindow != that1.MaxTwapWindow {
+		return false
+	}
+	if this.MaxReleasedDeliveriesPerBlock != that1.MaxReleasedDeliveriesPerBlock {
+		return false
+	}
+	if this.MaxOpenOffersPerListing != that1.MaxOpenOffersPerListing {
+		return false
+	}
+	if this.DisputeTimeout != that1.DisputeTimeout {
+		return false
+	}
+	if !this.DisputeSellerBondRate.Equal(that1.DisputeSellerBondRate) {
+		return false
+	}
+	if this.MaxSettledAuctionsPerBlock != that1.MaxSettledAuctionsPerBlock {
+		return false
+	}
+	if this.StatsSnapshotRetention != that1.StatsSnapshotRetention {
+		return false
+	}
 	return true
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (this *FeeSplit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.CommissionRate.Size()
-		i -= size
-		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	that1, ok := that.(*FeeSplit)
+	if !ok {
+		that2, ok := that.(FeeSplit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
-		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.CommunityPool.Equal(that1.CommunityPool) {
+		return false
+	}
+	if !this.Burn.Equal(that1.Burn) {
+		return false
+	}
+	if !this.Treasury.Equal(that1.Treasury) {
+		return false
+	}
+	return true
 }
+func (this *DenomCommission) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	that1, ok := that.(*DenomCommission)
+	if !ok {
+		that2, ok := that.(DenomCommission)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Rate.Equal(that1.Rate) {
+		return false
+	}
+	if !this.MinFee.Equal(that1.MinFee) {
+		return false
+	}
+	if !this.MaxFee.Equal(that1.MaxFee) {
+		return false
+	}
+	if len(this.Tiers) != len(that1.Tiers) {
+		return false
+	}
+	for i := range this.Tiers {
+		if !this.Tiers[i].Equal(&that1.Tiers[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *CommissionTier) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CommissionTier)
+	if !ok {
+		that2, ok := that.(CommissionTier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Threshold.Equal(that1.Threshold) {
+		return false
+	}
+	if !this.Rate.Equal(that1.Rate) {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StatsSnapshotRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StatsSnapshotRetention))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.MaxSettledAuctionsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSettledAuctionsPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	{
+		size := m.DisputeSellerBondRate.Size()
+		i -= size
+		if _, err := m.DisputeSellerBondRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.DisputeTimeout != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DisputeTimeout))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MaxOpenOffersPerListing != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxOpenOffersPerListing))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MaxReleasedDeliveriesPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxReleasedDeliveriesPerBlock))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxTwapWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTwapWindow))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.StatsEpochIdentifier) > 0 {
+		i -= len(m.StatsEpochIdentifier)
+		copy(dAtA[i:], m.StatsEpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.StatsEpochIdentifier)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.MaxActiveListingsPerSeller != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxActiveListingsPerSeller))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ListingDeposit != nil {
+		{
+			size, err := m.ListingDeposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	{
+		size := m.ReferralShare.Size()
+		i -= size
+		if _, err := m.ReferralShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size, err := m.FeeSplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.DenomCommissions) > 0 {
+		for iNdEx := len(m.DenomCommissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomCommissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size := m.MaxRoyaltyRate.Size()
+		i -= size
+		if _, err := m.MaxRoyaltyRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.MaxBatchSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBatchSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DisputeDeposit != nil {
+		{
+			size, err := m.DisputeDeposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.DeliveryTimeout != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DeliveryTimeout))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxExpiredListingsPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxExpiredListingsPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Treasury.Size()
+		i -= size
+		if _, err := m.Treasury.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Burn.Size()
+		i -= size
+		if _, err := m.Burn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.CommunityPool.Size()
+		i -= size
+		if _, err := m.CommunityPool.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventTreasurySpent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTreasurySpent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTreasurySpent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tiers) > 0 {
+		for iNdEx := len(m.Tiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.MaxFee.Size()
+		i -= size
+		if _, err := m.MaxFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinFee.Size()
+		i -= size
+		if _, err := m.MinFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommissionTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommissionTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommissionTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Threshold.Size()
+		i -= size
+		if _, err := m.Threshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxExpiredListingsPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxExpiredListingsPerBlock))
+	}
+	if m.DeliveryTimeout != 0 {
+		n += 1 + sovParams(uint64(m.DeliveryTimeout))
+	}
+	if m.DisputeDeposit != nil {
+		l = m.DisputeDeposit.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxBatchSize != 0 {
+		n += 1 + sovParams(uint64(m.MaxBatchSize))
+	}
+	l = m.MaxRoyaltyRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.DenomCommissions) > 0 {
+		for _, e := range m.DenomCommissions {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.FeeSplit.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.ReferralShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ListingDeposit != nil {
+		l = m.ListingDeposit.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxActiveListingsPerSeller != 0 {
+		n += 1 + sovParams(uint64(m.MaxActiveListingsPerSeller))
+	}
+	l = len(m.StatsEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MaxTwapWindow != 0 {
+		n += 1 + sovParams(uint64(m.MaxTwapWindow))
+	}
+	if m.MaxReleasedDeliveriesPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxReleasedDeliveriesPerBlock))
+	}
+	if m.MaxOpenOffersPerListing != 0 {
+		n += 1 + sovParams(uint64(m.MaxOpenOffersPerListing))
+	}
+	if m.DisputeTimeout != 0 {
+		n += 2 + sovParams(uint64(m.DisputeTimeout))
+	}
+	l = m.DisputeSellerBondRate.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.MaxSettledAuctionsPerBlock != 0 {
+		n += 2 + sovParams(uint64(m.MaxSettledAuctionsPerBlock))
+	}
+	if m.StatsSnapshotRetention != 0 {
+		n += 2 + sovParams(uint64(m.StatsSnapshotRetention))
+	}
+	return n
+}
+
+func (m *FeeSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CommunityPool.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Burn.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Treasury.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *EventTreasurySpent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DenomCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MinFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.Tiers) > 0 {
+		for _, e := range m.Tiers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommissionTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Threshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Rate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExpiredListingsPerBlock", wireType)
+			}
+			m.MaxExpiredListingsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExpiredListingsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeliveryTimeout", wireType)
+			}
+			m.DeliveryTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeliveryTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DisputeDeposit == nil {
+				m.DisputeDeposit = &types.Coin{}
+			}
+			if err := m.DisputeDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
+			}
+			m.MaxBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRoyaltyRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRoyaltyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCommissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomCommissions = append(m.DenomCommissions, DenomCommission{})
+			if err := m.DenomCommissions[len(m.DenomCommissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferralShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReferralShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListingDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ListingDeposit == nil {
+				m.ListingDeposit = &types.Coin{}
+			}
+			if err := m.ListingDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActiveListingsPerSeller", wireType)
+			}
+			m.MaxActiveListingsPerSeller = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActiveListingsPerSeller |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatsEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTwapWindow", wireType)
+			}
+			m.MaxTwapWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTwapWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReleasedDeliveriesPerBlock", wireType)
+			}
+			m.MaxReleasedDeliveriesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReleasedDeliveriesPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxOpenOffersPerListing", wireType)
+			}
+			m.MaxOpenOffersPerListing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxOpenOffersPerListing |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeTimeout", wireType)
+			}
+			m.DisputeTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeSellerBondRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DisputeSellerBondRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSettledAuctionsPerBlock", wireType)
+			}
+			m.MaxSettledAuctionsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSettledAuctionsPerBlock |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatsSnapshotRetention", wireType)
+			}
+			m.StatsSnapshotRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StatsSnapshotRetention |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPool.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Burn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Treasury.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventTreasurySpent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTreasurySpent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTreasurySpent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.CommissionRate.Size()
-	n += 1 + l + sovParams(uint64(l))
-	return n
+	return nil
 }
+func (m *DenomCommission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomCommission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomCommission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tiers = append(m.Tiers, CommissionTier{})
+			if err := m.Tiers[len(m.Tiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *CommissionTier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -196,15 +2277,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommissionTier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommissionTier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -232,7 +2313,41 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Threshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
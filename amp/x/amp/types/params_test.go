@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"amp/x/amp/types"
+)
+
+func TestParamsCommission(t *testing.T) {
+	params := types.DefaultParams()
+	params.CommissionRate = sdkmath.LegacyNewDecWithPrec(1, 1)
+	params.DenomCommissions = []types.DenomCommission{{
+		Denom:  "uatom",
+		Rate:   sdkmath.LegacyNewDecWithPrec(5, 2),
+		MinFee: sdkmath.NewInt(3),
+		MaxFee: sdkmath.NewInt(400),
+		Tiers: []types.CommissionTier{
+			{Threshold: sdkmath.NewInt(1000), Rate: sdkmath.LegacyNewDecWithPrec(2, 2)},
+			{Threshold: sdkmath.NewInt(10000), Rate: sdkmath.LegacyNewDecWithPrec(1, 2)},
+		},
+	}}
+	require.NoError(t, params.Validate())
+
+	fee := func(amount int64, denom string) int64 {
+		return params.Commission(sdk.NewInt64Coin(denom, amount)).Int64()
+	}
+	// the default rate rounds up, so small sales still pay commission
+	require.Equal(t, int64(1), fee(5, "stake"))
+	require.Equal(t, int64(10), fee(100, "stake"))
+	// the min fee applies but never exceeds the price
+	require.Equal(t, int64(3), fee(20, "uatom"))
+	require.Equal(t, int64(2), fee(2, "uatom"))
+	require.Equal(t, int64(45), fee(900, "uatom"))
+	require.Equal(t, int64(20), fee(1000, "uatom"))
+	require.Equal(t, int64(100), fee(10000, "uatom"))
+	require.Equal(t, int64(400), fee(100000, "uatom"))
+
+	invalid := []types.DenomCommission{
+		{Denom: "uatom", Rate: sdkmath.LegacyNewDec(2)},
+		{Denom: "uatom", Rate: sdkmath.LegacyZeroDec(), MinFee: sdkmath.NewInt(5), MaxFee: sdkmath.NewInt(4)},
+		{Denom: "uatom", Rate: sdkmath.LegacyZeroDec(), MinFee: sdkmath.NewInt(-1)},
+		{Denom: "uatom", Rate: sdkmath.LegacyZeroDec(), Tiers: []types.CommissionTier{
+			{Threshold: sdkmath.NewInt(10), Rate: sdkmath.LegacyZeroDec()},
+			{Threshold: sdkmath.NewInt(10), Rate: sdkmath.LegacyZeroDec()},
+		}},
+	}
+	for _, dc := range invalid {
+		p := types.DefaultParams()
+		p.DenomCommissions = []types.DenomCommission{dc}
+		require.ErrorIs(t, p.Validate(), types.ErrInvalidCommission)
+	}
+
+	dup := types.DefaultParams()
+	dup.DenomCommissions = []types.DenomCommission{
+		{Denom: "uatom", Rate: sdkmath.LegacyZeroDec()},
+		{Denom: "uatom", Rate: sdkmath.LegacyZeroDec()},
+	}
+	require.ErrorIs(t, dup.Validate(), types.ErrInvalidCommission)
+}
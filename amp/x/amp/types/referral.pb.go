@@ -0,0 +1,648 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: amp/amp/v1/referral.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ReferralPayment is the share of a sale's commission paid to a referrer
+type ReferralPayment struct {
+	Referrer string     `protobuf:"bytes,1,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	Amount   types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *ReferralPayment) Reset()         { *m = ReferralPayment{} }
+func (m *ReferralPayment) String() string { return proto.CompactTextString(m) }
+func (*ReferralPayment) ProtoMessage()    {}
+func (*ReferralPayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28b973b40a8d8887, []int{0}
+}
+func (m *ReferralPayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReferralPayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReferralPayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReferralPayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferralPayment.Merge(m, src)
+}
+func (m *ReferralPayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReferralPayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferralPayment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferralPayment proto.InternalMessageInfo
+
+func (m *ReferralPayment) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
+	}
+	return ""
+}
+
+func (m *ReferralPayment) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// ReferrerStats totals what a referrer has earned from referred sales
+type ReferrerStats struct {
+	Referrer string                                   `protobuf:"bytes,1,opt,name=referrer,proto3" json:"referrer,omitempty"`
+	Earned   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=earned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"earned"`
+	Sales    uint64                                   `protobuf:"varint,3,opt,name=sales,proto3" json:"sales,omitempty"`
+}
+
+func (m *ReferrerStats) Reset()         { *m = ReferrerStats{} }
+func (m *ReferrerStats) String() string { return proto.CompactTextString(m) }
+func (*ReferrerStats) ProtoMessage()    {}
+func (*ReferrerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_28b973b40a8d8887, []int{1}
+}
+func (m *ReferrerStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReferrerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReferrerStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReferrerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferrerStats.Merge(m, src)
+}
+func (m *ReferrerStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReferrerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferrerStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferrerStats proto.InternalMessageInfo
+
+func (m *ReferrerStats) GetReferrer() string {
+	if m != nil {
+		return m.Referrer
+	}
+	return ""
+}
+
+func (m *ReferrerStats) GetEarned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Earned
+	}
+	return nil
+}
+
+func (m *ReferrerStats) GetSales() uint64 {
+	if m != nil {
+		return m.Sales
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ReferralPayment)(nil), "amp.amp.v1.ReferralPayment")
+	proto.RegisterType((*ReferrerStats)(nil), "amp.amp.v1.ReferrerStats")
+}
+
+func init() { proto.RegisterFile("amp/amp/v1/referral.proto", fileDescriptor_28b973b40a8d8887) }
+
+var fileDescriptor_28b973b40a8d8887 = []byte{
+	// 293 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xb1, 0x4e, 0xf3, 0x30,
+	0x10, 0x80, 0xe3, 0xb6, 0x7f, 0xf5, 0x63, 0x84, 0x2a, 0x45, 0x1d, 0xd2, 0x0e, 0x6e, 0xd4, 0x29,
+	0x0c, 0xd8, 0x04, 0x06, 0xf6, 0xf2, 0x02, 0xc8, 0x6c, 0x6c, 0x4e, 0xea, 0x86, 0x88, 0x3a, 0x8e,
+	0x6c, 0x37, 0xa2, 0x6f, 0xc1, 0x53, 0x30, 0xf0, 0x24, 0x1d, 0x3b, 0x32, 0x01, 0x4a, 0x5e, 0x04,
+	0x39, 0xb6, 0x18, 0x3b, 0x9c, 0x7c, 0x67, 0xdd, 0x7d, 0x9f, 0x74, 0x07, 0x67, 0x4c, 0xd4, 0xc4,
+	0x46, 0x93, 0x12, 0xc5, 0x37, 0x5c, 0x29, 0xb6, 0xc5, 0xb5, 0x92, 0x46, 0x86, 0x90, 0x89, 0x1a,
+	0xdb, 0x68, 0xd2, 0x39, 0xca, 0xa5, 0x16, 0x52, 0x93, 0x8c, 0x69, 0x4e, 0x9a, 0x34, 0xe3, 0x86,
+	0xa5, 0x24, 0x97, 0x65, 0xe5, 0x7a, 0xe7, 0xd3, 0x42, 0x16, 0xb2, 0x4f, 0x89, 0xcd, 0xdc, 0xef,
+	0x72, 0x03, 0x27, 0xd4, 0x33, 0x1f, 0xd8, 0x5e, 0xf0, 0xca, 0x84, 0x73, 0xf8, 0xdf, 0x69, 0xb8,
+	0x8a, 0x40, 0x0c, 0x92, 0x33, 0xfa, 0x57, 0x87, 0x77, 0x70, 0xcc, 0x84, 0xdc, 0x55, 0x26, 0x1a,
+	0xc4, 0x20, 0x39, 0xbf, 0x99, 0x61, 0x67, 0xc5, 0xd6, 0x8a, 0xbd, 0x15, 0xdf, 0xcb, 0xb2, 0x5a,
+	0x8d, 0x0e, 0x5f, 0x8b, 0x80, 0xfa, 0xf6, 0xe5, 0x3b, 0x80, 0x17, 0xd4, 0x53, 0x1e, 0x0d, 0x33,
+	0xfa, 0xa4, 0x26, 0x87, 0x63, 0xce, 0x54, 0xc5, 0xd7, 0xd1, 0x20, 0x1e, 0x9e, 0xd6, 0x5c, 0x5b,
+	0xcd, 0xc7, 0xf7, 0x22, 0x29, 0x4a, 0xf3, 0xbc, 0xcb, 0x70, 0x2e, 0x05, 0xf1, 0x9b, 0x70, 0xcf,
+	0x95, 0x5e, 0xbf, 0x10, 0xb3, 0xaf, 0xb9, 0xee, 0x07, 0x34, 0xf5, 0xe8, 0x70, 0x0a, 0xff, 0x69,
+	0xb6, 0xe5, 0x3a, 0x1a, 0xc6, 0x20, 0x19, 0x51, 0x57, 0xac, 0x2e, 0x0f, 0x2d, 0x02, 0xc7, 0x16,
+	0x81, 0x9f, 0x16, 0x81, 0xb7, 0x0e, 0x05, 0xc7, 0x0e, 0x05, 0x9f, 0x1d, 0x0a, 0x9e, 0x26, 0xf6,
+	0x06, 0xaf, 0xfd, 0x25, 0x7a, 0x5c, 0x36, 0xee, 0x57, 0x78, 0xfb, 0x3b, 0x00, 0xed, 0x2c, 0xfb,
+	0xaf, 0xa1, 0x01, 0x00, 0x00,
+}
+
+func (m *ReferralPayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReferralPayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReferralPayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintReferral(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintReferral(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReferrerStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReferrerStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReferrerStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sales != 0 {
+		i = encodeVarintReferral(dAtA, i, uint64(m.Sales))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Earned) > 0 {
+		for iNdEx := len(m.Earned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Earned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReferral(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Referrer) > 0 {
+		i -= len(m.Referrer)
+		copy(dAtA[i:], m.Referrer)
+		i = encodeVarintReferral(dAtA, i, uint64(len(m.Referrer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReferral(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReferral(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ReferralPayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovReferral(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovReferral(uint64(l))
+	return n
+}
+
+func (m *ReferrerStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Referrer)
+	if l > 0 {
+		n += 1 + l + sovReferral(uint64(l))
+	}
+	if len(m.Earned) > 0 {
+		for _, e := range m.Earned {
+			l = e.Size()
+			n += 1 + l + sovReferral(uint64(l))
+		}
+	}
+	if m.Sales != 0 {
+		n += 1 + sovReferral(uint64(m.Sales))
+	}
+	return n
+}
+
+func sovReferral(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReferral(x uint64) (n int) {
+	return sovReferral(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ReferralPayment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReferral
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReferralPayment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReferralPayment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReferral
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReferral
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReferral
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReferral
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReferral
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReferral
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReferral(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReferral
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReferrerStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReferral
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReferrerStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReferrerStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Referrer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReferral
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReferral
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReferral
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Referrer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Earned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReferral
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReferral
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReferral
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Earned = append(m.Earned, types.Coin{})
+			if err := m.Earned[len(m.Earned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sales", wireType)
+			}
+			m.Sales = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReferral
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sales |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReferral(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReferral
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReferral(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReferral
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReferral
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReferral
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReferral
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReferral
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReferral
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReferral        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReferral          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReferral = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1474 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: amp/amp/v1/royalty.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RoyaltyAssetKind tells what the asset of a royalty names
+type RoyaltyAssetKind int32
+
+const (
+	RoyaltyAssetKind_ROYALTY_ASSET_KIND_UNSPECIFIED RoyaltyAssetKind = 0
+	RoyaltyAssetKind_ROYALTY_ASSET_KIND_DENOM       RoyaltyAssetKind = 1
+	RoyaltyAssetKind_ROYALTY_ASSET_KIND_NFT_CLASS   RoyaltyAssetKind = 2
+)
+
+var RoyaltyAssetKind_name = map[int32]string{
+	0: "ROYALTY_ASSET_KIND_UNSPECIFIED",
+	1: "ROYALTY_ASSET_KIND_DENOM",
+	2: "ROYALTY_ASSET_KIND_NFT_CLASS",
+}
+
+var RoyaltyAssetKind_value = map[string]int32{
+	"ROYALTY_ASSET_KIND_UNSPECIFIED": 0,
+	"ROYALTY_ASSET_KIND_DENOM":       1,
+	"ROYALTY_ASSET_KIND_NFT_CLASS":   2,
+}
+
+func (x RoyaltyAssetKind) String() string {
+	return proto.EnumName(RoyaltyAssetKind_name, int32(x))
+}
+
+func (RoyaltyAssetKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f3f481b80cf16b1f, []int{0}
+}
+
+// Royalty is the share of every listing sale of an asset paid to a creator's recipient
+type Royalty struct {
+	Kind      RoyaltyAssetKind            `protobuf:"varint,1,opt,name=kind,proto3,enum=amp.amp.v1.RoyaltyAssetKind" json:"kind,omitempty"`
+	Asset     string                      `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Creator   string                      `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	Recipient string                      `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Rate      cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *Royalty) Reset()         { *m = Royalty{} }
+func (m *Royalty) String() string { return proto.CompactTextString(m) }
+func (*Royalty) ProtoMessage()    {}
+func (*Royalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3f481b80cf16b1f, []int{0}
+}
+func (m *Royalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Royalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Royalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Royalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Royalty.Merge(m, src)
+}
+func (m *Royalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *Royalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Royalty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Royalty proto.InternalMessageInfo
+
+func (m *Royalty) GetKind() RoyaltyAssetKind {
+	if m != nil {
+		return m.Kind
+	}
+	return RoyaltyAssetKind_ROYALTY_ASSET_KIND_UNSPECIFIED
+}
+
+func (m *Royalty) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *Royalty) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *Royalty) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// RoyaltyPayment is a royalty paid out of a sale
+type RoyaltyPayment struct {
+	Kind      RoyaltyAssetKind `protobuf:"varint,1,opt,name=kind,proto3,enum=amp.amp.v1.RoyaltyAssetKind" json:"kind,omitempty"`
+	Asset     string           `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Recipient string           `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    types.Coin       `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *RoyaltyPayment) Reset()         { *m = RoyaltyPayment{} }
+func (m *RoyaltyPayment) String() string { return proto.CompactTextString(m) }
+func (*RoyaltyPayment) ProtoMessage()    {}
+func (*RoyaltyPayment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3f481b80cf16b1f, []int{1}
+}
+func (m *RoyaltyPayment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoyaltyPayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoyaltyPayment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoyaltyPayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoyaltyPayment.Merge(m, src)
+}
+func (m *RoyaltyPayment) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoyaltyPayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoyaltyPayment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoyaltyPayment proto.InternalMessageInfo
+
+func (m *RoyaltyPayment) GetKind() RoyaltyAssetKind {
+	if m != nil {
+		return m.Kind
+	}
+	return RoyaltyAssetKind_ROYALTY_ASSET_KIND_UNSPECIFIED
+}
+
+func (m *RoyaltyPayment) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *RoyaltyPayment) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *RoyaltyPayment) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// Event emitted when the module authority assigns or revokes the creator of an asset
+type EventCreatorSet struct {
+	Kind    RoyaltyAssetKind `protobuf:"varint,1,opt,name=kind,proto3,enum=amp.amp.v1.RoyaltyAssetKind" json:"kind,omitempty"`
+	Asset   string           `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Creator string           `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *EventCreatorSet) Reset()         { *m = EventCreatorSet{} }
+func (m *EventCreatorSet) String() string { return proto.CompactTextString(m) }
+func (*EventCreatorSet) ProtoMessage()    {}
+func (*EventCreatorSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3f481b80cf16b1f, []int{2}
+}
+func (m *EventCreatorSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCreatorSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCreatorSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCreatorSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCreatorSet.Merge(m, src)
+}
+func (m *EventCreatorSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCreatorSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCreatorSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCreatorSet proto.InternalMessageInfo
+
+func (m *EventCreatorSet) GetKind() RoyaltyAssetKind {
+	if m != nil {
+		return m.Kind
+	}
+	return RoyaltyAssetKind_ROYALTY_ASSET_KIND_UNSPECIFIED
+}
+
+func (m *EventCreatorSet) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *EventCreatorSet) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+// Event emitted when a creator registers or changes a royalty
+type EventRoyaltySet struct {
+	Kind      RoyaltyAssetKind            `protobuf:"varint,1,opt,name=kind,proto3,enum=amp.amp.v1.RoyaltyAssetKind" json:"kind,omitempty"`
+	Asset     string                      `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
+	Creator   string                      `protobuf:"bytes,3,opt,name=creator,proto3" json:"creator,omitempty"`
+	Recipient string                      `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Rate      cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *EventRoyaltySet) Reset()         { *m = EventRoyaltySet{} }
+func (m *EventRoyaltySet) String() string { return proto.CompactTextString(m) }
+func (*EventRoyaltySet) ProtoMessage()    {}
+func (*EventRoyaltySet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f3f481b80cf16b1f, []int{3}
+}
+func (m *EventRoyaltySet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRoyaltySet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRoyaltySet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRoyaltySet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRoyaltySet.Merge(m, src)
+}
+func (m *EventRoyaltySet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRoyaltySet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRoyaltySet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRoyaltySet proto.InternalMessageInfo
+
+func (m *EventRoyaltySet) GetKind() RoyaltyAssetKind {
+	if m != nil {
+		return m.Kind
+	}
+	return RoyaltyAssetKind_ROYALTY_ASSET_KIND_UNSPECIFIED
+}
+
+func (m *EventRoyaltySet) GetAsset() string {
+	if m != nil {
+		return m.Asset
+	}
+	return ""
+}
+
+func (m *EventRoyaltySet) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *EventRoyaltySet) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("amp.amp.v1.RoyaltyAssetKind", RoyaltyAssetKind_name, RoyaltyAssetKind_value)
+	proto.RegisterType((*Royalty)(nil), "amp.amp.v1.Royalty")
+	proto.RegisterType((*RoyaltyPayment)(nil), "amp.amp.v1.RoyaltyPayment")
+	proto.RegisterType((*EventCreatorSet)(nil), "amp.amp.v1.EventCreatorSet")
+	proto.RegisterType((*EventRoyaltySet)(nil), "amp.amp.v1.EventRoyaltySet")
+}
+
+func init() { proto.RegisterFile("amp/amp/v1/royalty.proto", fileDescriptor_f3f481b80cf16b1f) }
+
+var fileDescriptor_f3f481b80cf16b1f = []byte{
+	// 451 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0xc1, 0x6a, 0xdb, 0x40,
+	0x10, 0xd5, 0x26, 0x4e, 0x42, 0xa6, 0x90, 0x98, 0x25, 0x07, 0x25, 0x35, 0x8a, 0xd1, 0x29, 0x2d,
+	0x74, 0x55, 0xa5, 0x87, 0x9e, 0x6d, 0x4b, 0x01, 0x13, 0x57, 0x09, 0x92, 0x7b, 0x48, 0x2f, 0x62,
+	0x2d, 0x2f, 0xae, 0x48, 0xa5, 0x15, 0xd2, 0x56, 0x54, 0x7f, 0xd1, 0x3f, 0xe9, 0xa5, 0x1f, 0x91,
+	0xa3, 0xe9, 0x29, 0xf4, 0x10, 0x8a, 0xfd, 0x23, 0x65, 0xb5, 0x5b, 0x42, 0x43, 0x8e, 0x6d, 0x0e,
+	0x0b, 0x9a, 0xf7, 0x9e, 0x76, 0xde, 0xd3, 0x68, 0xc0, 0xa4, 0x59, 0xe1, 0xc8, 0x53, 0xbb, 0x4e,
+	0xc9, 0x1b, 0xfa, 0x49, 0x34, 0xa4, 0x28, 0xb9, 0xe0, 0x18, 0x68, 0x56, 0x10, 0x79, 0x6a, 0xf7,
+	0xc8, 0x4a, 0x78, 0x95, 0xf1, 0xca, 0x99, 0xd1, 0x8a, 0x39, 0xb5, 0x3b, 0x63, 0x82, 0xba, 0x4e,
+	0xc2, 0xd3, 0x5c, 0x69, 0x8f, 0x0e, 0x15, 0x1f, 0xb7, 0x95, 0xa3, 0x0a, 0x4d, 0x1d, 0x2c, 0xf8,
+	0x82, 0x2b, 0x5c, 0x3e, 0x29, 0xd4, 0x5e, 0x22, 0xd8, 0x09, 0x55, 0x3b, 0xfc, 0x1a, 0x3a, 0xd7,
+	0x69, 0x3e, 0x37, 0x51, 0x1f, 0x9d, 0xec, 0x9d, 0xf6, 0xc8, 0x7d, 0x5f, 0xa2, 0x25, 0x83, 0xaa,
+	0x62, 0xe2, 0x3c, 0xcd, 0xe7, 0x61, 0xab, 0xc4, 0x07, 0xb0, 0x45, 0x25, 0x64, 0x6e, 0xf4, 0xd1,
+	0xc9, 0x6e, 0xa8, 0x0a, 0x6c, 0xc2, 0x4e, 0x52, 0x32, 0x2a, 0x78, 0x69, 0x6e, 0xb6, 0xf8, 0x9f,
+	0x12, 0xf7, 0x60, 0xb7, 0x64, 0x49, 0x5a, 0xa4, 0x2c, 0x17, 0x66, 0xa7, 0xe5, 0xee, 0x01, 0xec,
+	0x43, 0xa7, 0xa4, 0x82, 0x99, 0x5b, 0x92, 0x18, 0xba, 0x37, 0x77, 0xc7, 0xc6, 0xcf, 0xbb, 0xe3,
+	0xe7, 0x2a, 0x45, 0x35, 0xbf, 0x26, 0x29, 0x77, 0x32, 0x2a, 0x3e, 0x92, 0x09, 0x5b, 0xd0, 0xa4,
+	0xf1, 0x58, 0xf2, 0xe3, 0xfb, 0x2b, 0xd0, 0x21, 0x3d, 0x96, 0x84, 0xed, 0xeb, 0xf6, 0x37, 0x04,
+	0x7b, 0xda, 0xef, 0x25, 0x6d, 0x32, 0x79, 0xf3, 0xbf, 0x4a, 0xf6, 0x97, 0xff, 0xcd, 0x87, 0xfe,
+	0xdf, 0xc2, 0x36, 0xcd, 0xf8, 0x67, 0x1d, 0xed, 0xd9, 0xe9, 0x21, 0xd1, 0xde, 0xe4, 0xb4, 0x88,
+	0x9e, 0x16, 0x19, 0xf1, 0x34, 0x1f, 0x76, 0x64, 0xb8, 0x50, 0xcb, 0xed, 0x0a, 0xf6, 0xfd, 0x9a,
+	0xe5, 0x62, 0xa4, 0x3e, 0x53, 0xc4, 0xc4, 0xff, 0x9f, 0x85, 0x7d, 0x8b, 0x74, 0x57, 0x7d, 0xdf,
+	0x93, 0x74, 0x7d, 0x92, 0x3f, 0xe0, 0x65, 0x0d, 0xdd, 0x87, 0x76, 0xb1, 0x0d, 0x56, 0x78, 0x71,
+	0x35, 0x98, 0x4c, 0xaf, 0xe2, 0x41, 0x14, 0xf9, 0xd3, 0xf8, 0x7c, 0x1c, 0x78, 0xf1, 0xfb, 0x20,
+	0xba, 0xf4, 0x47, 0xe3, 0xb3, 0xb1, 0xef, 0x75, 0x0d, 0xdc, 0x03, 0xf3, 0x11, 0x8d, 0xe7, 0x07,
+	0x17, 0xef, 0xba, 0x08, 0xf7, 0xa1, 0xf7, 0x08, 0x1b, 0x9c, 0x4d, 0xe3, 0xd1, 0x64, 0x10, 0x45,
+	0xdd, 0x8d, 0xe1, 0x8b, 0x9b, 0x95, 0x85, 0x96, 0x2b, 0x0b, 0xfd, 0x5a, 0x59, 0xe8, 0xeb, 0xda,
+	0x32, 0x96, 0x6b, 0xcb, 0xb8, 0x5d, 0x5b, 0xc6, 0x87, 0x7d, 0xb9, 0xd9, 0x5f, 0xda, 0xfd, 0x16,
+	0x4d, 0xc1, 0xaa, 0xd9, 0x76, 0xbb, 0x7e, 0x6f, 0x7e, 0x0f, 0x00, 0xfe, 0x38, 0xc9, 0x42, 0xf7,
+	0x03, 0x00, 0x00,
+}
+
+func (m *Royalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Royalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Royalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRoyalty(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintRoyalty(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintRoyalty(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintRoyalty(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Kind != 0 {
+		i = encodeVarintRoyalty(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoyaltyPayment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoyaltyPayment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoyaltyPayment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRoyalty(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintRoyalty(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintRoyalty(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Kind != 0 {
+		i = encodeVarintRoyalty(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCreatorSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreatorSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreatorSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintRoyalty(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintRoyalty(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Kind != 0 {
+		i = encodeVarintRoyalty(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRoyaltySet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRoyaltySet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRoyaltySet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRoyalty(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintRoyalty(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintRoyalty(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Asset) > 0 {
+		i -= len(m.Asset)
+		copy(dAtA[i:], m.Asset)
+		i = encodeVarintRoyalty(dAtA, i, uint64(len(m.Asset)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Kind != 0 {
+		i = encodeVarintRoyalty(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRoyalty(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRoyalty(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Royalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kind != 0 {
+		n += 1 + sovRoyalty(uint64(m.Kind))
+	}
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovRoyalty(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovRoyalty(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovRoyalty(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovRoyalty(uint64(l))
+	return n
+}
+
+func (m *RoyaltyPayment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kind != 0 {
+		n += 1 + sovRoyalty(uint64(m.Kind))
+	}
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovRoyalty(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovRoyalty(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovRoyalty(uint64(l))
+	return n
+}
+
+func (m *EventCreatorSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kind != 0 {
+		n += 1 + sovRoyalty(uint64(m.Kind))
+	}
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovRoyalty(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovRoyalty(uint64(l))
+	}
+	return n
+}
+
+func (m *EventRoyaltySet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kind != 0 {
+		n += 1 + sovRoyalty(uint64(m.Kind))
+	}
+	l = len(m.Asset)
+	if l > 0 {
+		n += 1 + l + sovRoyalty(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovRoyalty(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovRoyalty(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovRoyalty(uint64(l))
+	return n
+}
+
+func sovRoyalty(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRoyalty(x uint64) (n int) {
+	return sovRoyalty(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Royalty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoyalty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Royalty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Royalty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoyalty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= RoyaltyAssetKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoyalty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoyalty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoyalty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoyalty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoyalty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoyaltyPayment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoyalty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoyaltyPayment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoyaltyPayment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoyalty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= RoyaltyAssetKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoyalty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoyalty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoyalty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoyalty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCreatorSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoyalty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreatorSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoyalty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= RoyaltyAssetKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoyalty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoyalty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoyalty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRoyaltySet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRoyalty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRoyaltySet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRoyaltySet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoyalty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= RoyaltyAssetKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Asset", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoyalty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Asset = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoyalty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoyalty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRoyalty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRoyalty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRoyalty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRoyalty(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRoyalty
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRoyalty
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRoyalty
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRoyalty
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRoyalty
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRoyalty
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRoyalty        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRoyalty          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRoyalty = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,9 @@
+package types
+
+import sdkmath "cosmossdk.io/math"
+
+// CumulativeAt returns the price accumulator at t, a time at or after the record, adding
+// the record's price for the seconds since it.
+func (r TwapRecord) CumulativeAt(t int64) sdkmath.LegacyDec {
+    return r.Cumulative.Add(r.Price.MulInt64(t - r.Time))
+}
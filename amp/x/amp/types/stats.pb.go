@@ -0,0 +1,1404 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: amp/amp/v1/stats.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DenomStats totals the settled listing sales paid in one price denom; delivery escrow
+// sales count once released or resolved
+type DenomStats struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// volume is the total price paid to the seller side, net of dispute refunds
+	Volume cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=volume,proto3,customtype=cosmossdk.io/math.Int" json:"volume"`
+	Sales  uint64                `protobuf:"varint,3,opt,name=sales,proto3" json:"sales,omitempty"`
+	// fees is the commission collected
+	Fees cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=fees,proto3,customtype=cosmossdk.io/math.Int" json:"fees"`
+}
+
+func (m *DenomStats) Reset()         { *m = DenomStats{} }
+func (m *DenomStats) String() string { return proto.CompactTextString(m) }
+func (*DenomStats) ProtoMessage()    {}
+func (*DenomStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11d406c03df9cec4, []int{0}
+}
+func (m *DenomStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomStats.Merge(m, src)
+}
+func (m *DenomStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomStats proto.InternalMessageInfo
+
+func (m *DenomStats) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomStats) GetSales() uint64 {
+	if m != nil {
+		return m.Sales
+	}
+	return 0
+}
+
+// AssetStats tracks the unit price an asset denom sold at in one price denom
+type AssetStats struct {
+	AssetDenom  string        `protobuf:"bytes,1,opt,name=asset_denom,json=assetDenom,proto3" json:"asset_denom,omitempty"`
+	LastPrice   types.DecCoin `protobuf:"bytes,2,opt,name=last_price,json=lastPrice,proto3" json:"last_price"`
+	AllTimeHigh types.DecCoin `protobuf:"bytes,3,opt,name=all_time_high,json=allTimeHigh,proto3" json:"all_time_high"`
+	LastSaleAt  int64         `protobuf:"varint,4,opt,name=last_sale_at,json=lastSaleAt,proto3" json:"last_sale_at,omitempty"`
+}
+
+func (m *AssetStats) Reset()         { *m = AssetStats{} }
+func (m *AssetStats) String() string { return proto.CompactTextString(m) }
+func (*AssetStats) ProtoMessage()    {}
+func (*AssetStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11d406c03df9cec4, []int{1}
+}
+func (m *AssetStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AssetStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AssetStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AssetStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AssetStats.Merge(m, src)
+}
+func (m *AssetStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *AssetStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_AssetStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AssetStats proto.InternalMessageInfo
+
+func (m *AssetStats) GetAssetDenom() string {
+	if m != nil {
+		return m.AssetDenom
+	}
+	return ""
+}
+
+func (m *AssetStats) GetLastPrice() types.DecCoin {
+	if m != nil {
+		return m.LastPrice
+	}
+	return types.DecCoin{}
+}
+
+func (m *AssetStats) GetAllTimeHigh() types.DecCoin {
+	if m != nil {
+		return m.AllTimeHigh
+	}
+	return types.DecCoin{}
+}
+
+func (m *AssetStats) GetLastSaleAt() int64 {
+	if m != nil {
+		return m.LastSaleAt
+	}
+	return 0
+}
+
+// MarketStats are the running market aggregates, or a snapshot of them taken at the end
+// of an epoch
+type MarketStats struct {
+	Denoms []DenomStats `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms"`
+	Assets []AssetStats `protobuf:"bytes,2,rep,name=assets,proto3" json:"assets"`
+	// epoch_number is the epoch a snapshot was taken at the end of; 0 for the running aggregates
+	EpochNumber int64 `protobuf:"varint,3,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	Time        int64 `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (m *MarketStats) Reset()         { *m = MarketStats{} }
+func (m *MarketStats) String() string { return proto.CompactTextString(m) }
+func (*MarketStats) ProtoMessage()    {}
+func (*MarketStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11d406c03df9cec4, []int{2}
+}
+func (m *MarketStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketStats.Merge(m, src)
+}
+func (m *MarketStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketStats proto.InternalMessageInfo
+
+func (m *MarketStats) GetDenoms() []DenomStats {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
+func (m *MarketStats) GetAssets() []AssetStats {
+	if m != nil {
+		return m.Assets
+	}
+	return nil
+}
+
+func (m *MarketStats) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *MarketStats) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+// TwapRecord is a point of the price accumulator of an (asset denom, price denom) pair,
+// written at every settled sale of the asset in the price denom
+type TwapRecord struct {
+	// price is the unit price of the sales at time weighted by their quantity, which holds
+	// until the next record
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+	// cumulative is the sum of unit prices times the seconds they held, from the first sale to time
+	Cumulative cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=cumulative,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"cumulative"`
+	Time       int64                       `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	// quantity is the amount of the asset sold at time
+	Quantity cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=quantity,proto3,customtype=cosmossdk.io/math.Int" json:"quantity"`
+}
+
+func (m *TwapRecord) Reset()         { *m = TwapRecord{} }
+func (m *TwapRecord) String() string { return proto.CompactTextString(m) }
+func (*TwapRecord) ProtoMessage()    {}
+func (*TwapRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_11d406c03df9cec4, []int{3}
+}
+func (m *TwapRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TwapRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TwapRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TwapRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TwapRecord.Merge(m, src)
+}
+func (m *TwapRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *TwapRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_TwapRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TwapRecord proto.InternalMessageInfo
+
+func (m *TwapRecord) GetTime() int64 {
+	if m != nil {
+		return m.Time
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DenomStats)(nil), "amp.amp.v1.DenomStats")
+	proto.RegisterType((*AssetStats)(nil), "amp.amp.v1.AssetStats")
+	proto.RegisterType((*MarketStats)(nil), "amp.amp.v1.MarketStats")
+	proto.RegisterType((*TwapRecord)(nil), "amp.amp.v1.TwapRecord")
+}
+
+func init() { proto.RegisterFile("amp/amp/v1/stats.proto", fileDescriptor_11d406c03df9cec4) }
+
+var fileDescriptor_11d406c03df9cec4 = []byte{
+	// 537 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x41, 0x6f, 0x13, 0x3d,
+	0x10, 0x8d, 0xb3, 0x69, 0xf4, 0x75, 0xb6, 0x9f, 0x90, 0xac, 0x52, 0x2d, 0x05, 0x6d, 0x42, 0x4e,
+	0x41, 0x88, 0x5d, 0xa5, 0x70, 0x47, 0x49, 0x23, 0x4a, 0x25, 0x40, 0xb0, 0xed, 0x89, 0xcb, 0xca,
+	0x71, 0x4c, 0x62, 0x75, 0xbd, 0x5e, 0x62, 0x27, 0x90, 0x1f, 0xc0, 0x9d, 0x9f, 0xc2, 0x81, 0x33,
+	0xe7, 0x1e, 0x2b, 0x4e, 0xa8, 0x87, 0x0a, 0x25, 0x7f, 0x04, 0xd9, 0xde, 0x34, 0x91, 0x7a, 0x81,
+	0x1e, 0x2c, 0x79, 0xc6, 0x7e, 0x33, 0xef, 0xbd, 0xb1, 0x61, 0x8f, 0x88, 0x22, 0x36, 0x6b, 0xd6,
+	0x89, 0x95, 0x26, 0x5a, 0x45, 0xc5, 0x44, 0x6a, 0x89, 0x81, 0x88, 0x22, 0x32, 0x6b, 0xd6, 0xd9,
+	0x0f, 0xa9, 0x54, 0x42, 0xaa, 0x78, 0x40, 0x14, 0x8b, 0x67, 0x9d, 0x01, 0xd3, 0xa4, 0x13, 0x53,
+	0xc9, 0x73, 0x77, 0x77, 0xff, 0x9e, 0x3b, 0x4f, 0x6d, 0x14, 0xbb, 0xa0, 0x3c, 0xda, 0x1d, 0xc9,
+	0x91, 0x74, 0x79, 0xb3, 0x73, 0xd9, 0xd6, 0x0f, 0x04, 0xd0, 0x67, 0xb9, 0x14, 0x27, 0xa6, 0x23,
+	0xde, 0x85, 0xad, 0xa1, 0x89, 0x02, 0xd4, 0x44, 0xed, 0xed, 0xc4, 0x05, 0xf8, 0x10, 0xea, 0x33,
+	0x99, 0x4d, 0x05, 0x0b, 0xaa, 0x26, 0xdd, 0x7b, 0x7c, 0x7e, 0xd5, 0xa8, 0x5c, 0x5e, 0x35, 0xee,
+	0xba, 0x06, 0x6a, 0x78, 0x16, 0x71, 0x19, 0x0b, 0xa2, 0xc7, 0xd1, 0x71, 0xae, 0x7f, 0x7e, 0x7f,
+	0x02, 0x65, 0xe7, 0xe3, 0x5c, 0x27, 0x25, 0xd4, 0x94, 0x56, 0x24, 0x63, 0x2a, 0xf0, 0x9a, 0xa8,
+	0x5d, 0x4b, 0x5c, 0x80, 0x9f, 0x43, 0xed, 0x03, 0x63, 0x2a, 0xa8, 0xfd, 0x7b, 0x61, 0x0b, 0x6c,
+	0x5d, 0x22, 0x80, 0xae, 0x52, 0x4c, 0x3b, 0x01, 0x0d, 0xf0, 0x89, 0x89, 0xd2, 0x4d, 0x19, 0x60,
+	0x53, 0x56, 0x26, 0xee, 0x02, 0x64, 0x44, 0xe9, 0xb4, 0x98, 0x70, 0xea, 0xf4, 0xf8, 0x07, 0x0f,
+	0xa2, 0xb2, 0xac, 0xb1, 0x35, 0x2a, 0x6d, 0x8d, 0xfa, 0x8c, 0x1e, 0x4a, 0x9e, 0xf7, 0x6a, 0x86,
+	0x54, 0xb2, 0x6d, 0x50, 0x6f, 0x0d, 0x08, 0xbf, 0x80, 0xff, 0x49, 0x96, 0xa5, 0x9a, 0x0b, 0x96,
+	0x8e, 0xf9, 0x68, 0x1c, 0x78, 0x7f, 0x5d, 0xc5, 0x27, 0x59, 0x76, 0xca, 0x05, 0x7b, 0xc9, 0x47,
+	0x63, 0xdc, 0x84, 0x1d, 0x4b, 0xc5, 0x38, 0x91, 0x12, 0x6d, 0x3d, 0xf0, 0x12, 0x4b, 0xef, 0x84,
+	0x64, 0xac, 0xab, 0x5b, 0xdf, 0x10, 0xf8, 0xaf, 0xc9, 0xe4, 0x6c, 0xa5, 0xee, 0x19, 0xd4, 0xad,
+	0x2e, 0x15, 0xa0, 0xa6, 0xd7, 0xf6, 0x0f, 0xf6, 0xa2, 0xf5, 0xdb, 0x88, 0xd6, 0x63, 0x2c, 0x9b,
+	0x95, 0x77, 0x0d, 0xca, 0x1a, 0xa0, 0x82, 0xea, 0x4d, 0xd4, 0xda, 0xbb, 0x15, 0xca, 0xdd, 0xc5,
+	0x0f, 0x61, 0x87, 0x15, 0x92, 0x8e, 0xd3, 0x7c, 0x2a, 0x06, 0x6c, 0x62, 0x45, 0x7a, 0x89, 0x6f,
+	0x73, 0x6f, 0x6c, 0x0a, 0x63, 0xa8, 0x19, 0x13, 0x4a, 0xe2, 0x76, 0xdf, 0xfa, 0x52, 0x05, 0x38,
+	0xfd, 0x44, 0x8a, 0x84, 0x51, 0x39, 0x19, 0xe2, 0x23, 0xd8, 0x72, 0x4e, 0xdb, 0x49, 0xf4, 0x3a,
+	0xe5, 0x80, 0xef, 0xdf, 0x1c, 0xf0, 0x2b, 0x36, 0x22, 0x74, 0xde, 0x67, 0x74, 0x63, 0xcc, 0x7d,
+	0x46, 0x13, 0x87, 0xc7, 0xef, 0x00, 0xe8, 0x54, 0x4c, 0x33, 0xa2, 0xf9, 0x6c, 0xf5, 0x0e, 0x6f,
+	0x51, 0x6d, 0xa3, 0xc8, 0x35, 0x7d, 0x6f, 0x4d, 0x1f, 0x1f, 0xc1, 0x7f, 0x1f, 0xa7, 0x24, 0xd7,
+	0x5c, 0xcf, 0x6f, 0xf3, 0x26, 0xaf, 0xc1, 0xbd, 0x47, 0xe7, 0x8b, 0x10, 0x5d, 0x2c, 0x42, 0xf4,
+	0x7b, 0x11, 0xa2, 0xaf, 0xcb, 0xb0, 0x72, 0xb1, 0x0c, 0x2b, 0xbf, 0x96, 0x61, 0xe5, 0xfd, 0x1d,
+	0xf3, 0xc7, 0x3f, 0xdb, 0x9f, 0xae, 0xe7, 0x05, 0x53, 0x83, 0xba, 0xfd, 0x8a, 0x4f, 0xff, 0x0c,
+	0x00, 0x63, 0xc6, 0x1f, 0x87, 0x01, 0x04, 0x00, 0x00,
+}
+
+func (m *DenomStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fees.Size()
+		i -= size
+		if _, err := m.Fees.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Sales != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.Sales))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintStats(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AssetStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AssetStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AssetStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastSaleAt != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.LastSaleAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.AllTimeHigh.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.LastPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.AssetDenom) > 0 {
+		i -= len(m.AssetDenom)
+		copy(dAtA[i:], m.AssetDenom)
+		i = encodeVarintStats(dAtA, i, uint64(len(m.AssetDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Time != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Assets) > 0 {
+		for iNdEx := len(m.Assets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Assets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Denoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStats(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TwapRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TwapRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TwapRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Quantity.Size()
+		i -= size
+		if _, err := m.Quantity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Time != 0 {
+		i = encodeVarintStats(dAtA, i, uint64(m.Time))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Cumulative.Size()
+		i -= size
+		if _, err := m.Cumulative.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStats(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStats(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStats(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DenomStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovStats(uint64(l))
+	}
+	l = m.Volume.Size()
+	n += 1 + l + sovStats(uint64(l))
+	if m.Sales != 0 {
+		n += 1 + sovStats(uint64(m.Sales))
+	}
+	l = m.Fees.Size()
+	n += 1 + l + sovStats(uint64(l))
+	return n
+}
+
+func (m *AssetStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AssetDenom)
+	if l > 0 {
+		n += 1 + l + sovStats(uint64(l))
+	}
+	l = m.LastPrice.Size()
+	n += 1 + l + sovStats(uint64(l))
+	l = m.AllTimeHigh.Size()
+	n += 1 + l + sovStats(uint64(l))
+	if m.LastSaleAt != 0 {
+		n += 1 + sovStats(uint64(m.LastSaleAt))
+	}
+	return n
+}
+
+func (m *MarketStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, e := range m.Denoms {
+			l = e.Size()
+			n += 1 + l + sovStats(uint64(l))
+		}
+	}
+	if len(m.Assets) > 0 {
+		for _, e := range m.Assets {
+			l = e.Size()
+			n += 1 + l + sovStats(uint64(l))
+		}
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovStats(uint64(m.EpochNumber))
+	}
+	if m.Time != 0 {
+		n += 1 + sovStats(uint64(m.Time))
+	}
+	return n
+}
+
+func (m *TwapRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovStats(uint64(l))
+	l = m.Cumulative.Size()
+	n += 1 + l + sovStats(uint64(l))
+	if m.Time != 0 {
+		n += 1 + sovStats(uint64(m.Time))
+	}
+	l = m.Quantity.Size()
+	n += 1 + l + sovStats(uint64(l))
+	return n
+}
+
+func sovStats(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStats(x uint64) (n int) {
+	return sovStats(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DenomStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sales", wireType)
+			}
+			m.Sales = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sales |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AssetStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AssetStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AssetStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AssetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllTimeHigh", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AllTimeHigh.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastSaleAt", wireType)
+			}
+			m.LastSaleAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastSaleAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MarketStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MarketStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MarketStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, DenomStats{})
+			if err := m.Denoms[len(m.Denoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Assets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Assets = append(m.Assets, AssetStats{})
+			if err := m.Assets[len(m.Assets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TwapRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStats
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TwapRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TwapRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cumulative", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cumulative.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			m.Time = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Time |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStats
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStats
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quantity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStats(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStats
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStats(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStats
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStats
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStats
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStats
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStats
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStats        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStats          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStats = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,9 +1,35 @@
 package types
 
 const (
-    EventTypeItemListed   = "item_listed"
-    EventTypeItemBought   = "item_bought"
-    EventTypeItemDelisted = "item_delisted"
+    EventTypeItemListed    = "item_listed"
+    EventTypeItemBought    = "item_bought"
+    EventTypeItemDelisted  = "item_delisted"
+    EventTypeItemExpired   = "item_expired"
+    EventTypeItemUpdated   = "item_updated"
+    EventTypeItemTakenDown = "item_taken_down"
+
+    EventTypeListingDepositSettled = "listing_deposit_settled"
+
+    EventTypeRoyaltySet = "royalty_set"
+    EventTypeCreatorSet = "creator_set"
+
+    EventTypeDeliveryReleased = "delivery_released"
+    EventTypeDisputeOpened    = "dispute_opened"
+    EventTypeDisputeResolved  = "dispute_resolved"
+
+    EventTypeAuctionCreated = "auction_created"
+    EventTypeBidPlaced      = "bid_placed"
+    EventTypeAuctionSettled = "auction_settled"
+
+    EventTypeOfferMade     = "offer_made"
+    EventTypeOfferAccepted = "offer_accepted"
+    EventTypeOfferClosed   = "offer_closed"
+
+    EventTypeOrderPlaced    = "order_placed"
+    EventTypeOrderFilled    = "order_filled"
+    EventTypeOrderCancelled = "order_cancelled"
+
+    EventTypeTreasurySpent = "treasury_spent"
 
     AttributeKeyListingID = "listing_id"
     AttributeKeySeller    = "seller"
@@ -11,4 +37,46 @@ const (
     AttributeKeyAsset     = "asset"
     AttributeKeyPrice     = "price"
     AttributeKeyFee       = "fee"
+    AttributeKeyQuantity  = "quantity"
+    AttributeKeyRevision  = "revision"
+    AttributeKeyRoyalty   = "royalty"
+
+    AttributeKeyNFTClassID = "nft_class_id"
+    AttributeKeyNFTID      = "nft_id"
+
+    AttributeKeyTimedOut = "timed_out"
+
+    AttributeKeyArbiter     = "arbiter"
+    AttributeKeyReason      = "reason"
+    AttributeKeyBuyerRatio  = "buyer_ratio"
+    AttributeKeyBuyerAmount = "buyer_amount"
+    AttributeKeySellerBond  = "seller_bond"
+
+    AttributeKeyAuctionID = "auction_id"
+    AttributeKeyBidder    = "bidder"
+    AttributeKeyAmount    = "amount"
+    AttributeKeyWinner    = "winner"
+    AttributeKeyEndTime   = "end_time"
+
+    AttributeKeyOfferID   = "offer_id"
+    AttributeKeyExpiresAt = "expires_at"
+    AttributeKeyStatus    = "status"
+
+    AttributeKeyOrderID      = "order_id"
+    AttributeKeyMakerOrderID = "maker_order_id"
+    AttributeKeyTakerOrderID = "taker_order_id"
+    AttributeKeyOwner        = "owner"
+    AttributeKeySide         = "side"
+    AttributeKeyUnitPrice    = "unit_price"
+
+    AttributeKeyKind      = "kind"
+    AttributeKeyCreator   = "creator"
+    AttributeKeyRecipient = "recipient"
+    AttributeKeyRate      = "rate"
+
+    AttributeKeyReferrer = "referrer"
+    AttributeKeyReferral = "referral"
+
+    AttributeKeyDeposit   = "deposit"
+    AttributeKeyForfeited = "forfeited"
 )